@@ -0,0 +1,118 @@
+// Command fake-exchanger запускает поддельный сервис обменника для локальной разработки:
+// приложение подключается к нему по grpc_clients.grpc_exchanger вместо внешнего сервиса.
+//
+//	go run ./cmd/fake-exchanger -addr :44044 -rates USD=1,EUR=0.92,RUB=90 -script unavailable,delay=3s,ok
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/fakeexchanger"
+	grpcapp "github.com/Sanchir01/currency-wallet/pkg/server/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func main() {
+	addr := flag.String("addr", ":44044", "listen address")
+	rates := flag.String("rates", "USD=1,EUR=0.92,RUB=90", "rates against a common base, CUR=value,...")
+	latency := flag.Duration("latency", 0, "delay of every response")
+	failRate := flag.Float64("fail-rate", 0, "share of calls failing with -fail-code, 0..1")
+	failCode := flag.String("fail-code", "Unavailable", "gRPC code of random failures")
+	script := flag.String("script", "", "behaviour of the first calls in order: ok, <code>, delay=<duration>")
+	flag.Parse()
+
+	parsedRates, err := parseRates(*rates)
+	if err != nil {
+		log.Fatalf("-rates: %v", err)
+	}
+	code, err := parseCode(*failCode)
+	if err != nil {
+		log.Fatalf("-fail-code: %v", err)
+	}
+	steps, err := parseScript(*script)
+	if err != nil {
+		log.Fatalf("-script: %v", err)
+	}
+
+	srv := fakeexchanger.New(parsedRates)
+	srv.SetLatency(*latency)
+	srv.SetFailRate(*failRate, code)
+	srv.Script(steps...)
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("listen %s: %v", *addr, err)
+	}
+	gs := fakeexchanger.NewGRPCServer(srv, grpc.ChainUnaryInterceptor(grpcapp.RecoveryInterceptor))
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		<-quit
+		gs.GracefulStop()
+	}()
+	slog.Info("fake exchanger started", slog.String("addr", lis.Addr().String()), slog.Any("rates", parsedRates))
+	if err := gs.Serve(lis); err != nil {
+		log.Fatalf("serve: %v", err)
+	}
+}
+
+func parseRates(s string) (map[string]float32, error) {
+	rates := make(map[string]float32)
+	for _, pair := range strings.Split(s, ",") {
+		currency, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not CUR=value", pair)
+		}
+		v, err := strconv.ParseFloat(value, 32)
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("%q: rate must be a positive number", pair)
+		}
+		rates[strings.ToUpper(currency)] = float32(v)
+	}
+	return rates, nil
+}
+
+func parseScript(s string) ([]fakeexchanger.Step, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var steps []fakeexchanger.Step
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if d, ok := strings.CutPrefix(item, "delay="); ok {
+			delay, err := time.ParseDuration(d)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %w", item, err)
+			}
+			steps = append(steps, fakeexchanger.Step{Delay: delay})
+			continue
+		}
+		code, err := parseCode(item)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, fakeexchanger.Step{Code: code})
+	}
+	return steps, nil
+}
+
+// parseCode принимает имя кода gRPC без учёта регистра: ok, unavailable, deadline_exceeded и т.п.
+func parseCode(s string) (codes.Code, error) {
+	var code codes.Code
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if err := code.UnmarshalJSON([]byte(`"` + name + `"`)); err != nil {
+		return 0, fmt.Errorf("unknown gRPC code %q", s)
+	}
+	return code, nil
+}
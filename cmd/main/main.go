@@ -3,9 +3,14 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/Sanchir01/currency-wallet/internal/app"
+	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
 	httphandlers "github.com/Sanchir01/currency-wallet/internal/http"
 	"github.com/Sanchir01/currency-wallet/internal/profiling"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
 	httpserver "github.com/Sanchir01/currency-wallet/pkg/server/http"
 	"log"
 	"log/slog"
@@ -16,8 +21,9 @@ import (
 )
 
 // @title 🚀 Currency Wallet
-// @version         1.0
-// @description This is a sample server seller
+// @version         1.0.0
+// @description Currency wallet HTTP API. The contract is published in api/openapi.json,
+// @description pkg/client is generated from it
 // @termsOfService  http://swagger.io/terms/
 
 // @host localhost:5000
@@ -36,6 +42,12 @@ import (
 func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrate(ctx, os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	env, err := app.NewApp(ctx)
 	if err != nil {
 		log.Fatal(err)
@@ -45,9 +57,27 @@ func main() {
 		env.Cfg.HTTPServer.Timeout, env.Cfg.HTTPServer.IdleTimeout)
 	prometheusserver := httpserver.NewHTTPServer(env.Cfg.Prometheus.Host, env.Cfg.Prometheus.Port, env.Cfg.Prometheus.Timeout,
 		env.Cfg.Prometheus.IdleTimeout)
+	env.Keys.StartRefresh(ctx, user.KeyRefreshInterval, env.Lg)
+	env.DB.Reads.StartHealthCheck(ctx)
+	env.Services.AuditService.StartChainWriter(ctx, env.Cfg.Audit.ChainInterval)
 	env.Services.EventService.StartCreateEvent(ctx, 5*time.Second, 10, env.Cfg.Kafka.Notification.Topic[0])
+	if env.Cfg.Scheduler.Enabled {
+		env.Services.ScheduleService.StartScheduler(ctx, env.Cfg.Scheduler.Interval, env.Cfg.Scheduler.Lease, env.Cfg.Scheduler.BatchSize)
+	}
+	if env.Cfg.LimitOrders.Enabled {
+		env.Services.OrderService.StartWatcher(ctx, env.Cfg.LimitOrders.Interval, env.Cfg.LimitOrders.BatchSize)
+	}
+	if env.Cfg.Holds.Enabled {
+		env.Services.WalletService.StartHoldExpiry(ctx, env.Cfg.Holds.ExpiryInterval, env.Cfg.Holds.BatchSize)
+	}
+	if env.Cfg.Valuation.Enabled {
+		env.Services.WalletService.StartBalanceSnapshots(ctx, env.Cfg.Valuation.SnapshotInterval)
+	}
+	if env.Cfg.RateHistory.Enabled {
+		env.Services.RateService.StartRecorder(ctx, env.Cfg.RateHistory.Interval)
+	}
 	if err := profiling.InitPyroscope(); err != nil {
-		env.Lg.Error("Error initializing profiling: %v", err)
+		env.Lg.Error("Error initializing profiling", slog.String("error", err.Error()))
 	}
 	defer func() {
 		if err := env.Kafka.Close(); err != nil {
@@ -56,7 +86,7 @@ func main() {
 		}
 	}()
 	go func() {
-		if err := serve.Run(httphandlers.StartHTTTPHandlers(env.Handlers, env.Cfg.Domain, env.Lg)); err != nil {
+		if err := serve.Run(httphandlers.StartHTTTPHandlers(env.Handlers, env.Cfg.Domain, env.Services.UserService, env.DB.Reads, env.RateLimiter, env.Cfg.RateLimit, env.Cfg.HTTPServer.MaxBodyBytes, env.Cfg.HTTPServer.Timeout, env.Lg)); err != nil {
 			if !errors.Is(err, context.Canceled) {
 				env.Lg.Error("Listen server error", slog.String("error", err.Error()))
 				return
@@ -80,3 +110,31 @@ func main() {
 		env.Lg.Error("Close database", slog.String("error", err.Error()))
 	}
 }
+
+// migrate выполняет `main migrate up|down|status`: подключается только к Postgres, остальные зависимости не нужны.
+func migrate(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: main migrate up|down|status")
+	}
+	cfg := config.InitConfig()
+	pool, err := db.PGXNew(cfg, ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	migrator, err := db.NewMigrator(pool, logger.SetupLogger(cfg.Env))
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		return migrator.Down(ctx)
+	case "status":
+		return migrator.Status(ctx, os.Stdout)
+	default:
+		return fmt.Errorf("unknown migrate command %q, want up, down or status", args[0])
+	}
+}
@@ -0,0 +1,39 @@
+// Command openapi переводит сгенерированную swag спецификацию Swagger 2.0
+// в OpenAPI 3 — её используют генератор клиента pkg/client и контрактный тест роутера.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+)
+
+func main() {
+	in := flag.String("in", "docs/swagger.json", "swagger 2.0 spec generated by swag")
+	out := flag.String("out", "api/openapi.json", "OpenAPI 3 spec")
+	flag.Parse()
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("read %s: %v", *in, err)
+	}
+	var doc2 openapi2.T
+	if err := json.Unmarshal(data, &doc2); err != nil {
+		log.Fatalf("parse %s: %v", *in, err)
+	}
+	doc3, err := openapi2conv.ToV3(&doc2)
+	if err != nil {
+		log.Fatalf("convert: %v", err)
+	}
+	result, err := json.MarshalIndent(doc3, "", "  ")
+	if err != nil {
+		log.Fatalf("marshal: %v", err)
+	}
+	if err := os.WriteFile(*out, append(result, '\n'), 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+}
@@ -0,0 +1,424 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/app"
+	"github.com/Sanchir01/currency-wallet/internal/feature/admin"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/events"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+	"github.com/google/uuid"
+)
+
+type cli struct {
+	env *app.Admin
+	out *printer
+}
+
+func (c *cli) run(ctx context.Context, args []string) error {
+	command, rest := args[0], args[1:]
+	switch command {
+	case "users":
+		return c.sub(ctx, "users", rest, map[string]func(context.Context, []string) error{
+			"find":     c.usersFind,
+			"set-role": c.usersSetRole,
+		})
+	case "balances":
+		return c.balances(ctx, rest)
+	case "transactions":
+		return c.transactions(ctx, rest)
+	case "adjust":
+		return c.adjust(ctx, rest)
+	case "outbox":
+		return c.sub(ctx, "outbox", rest, map[string]func(context.Context, []string) error{
+			"list":    c.outboxList,
+			"requeue": c.outboxRequeue,
+			"replay":  c.outboxReplay,
+		})
+	case "reconcile":
+		return c.reconcile(ctx, rest)
+	case "keys":
+		return c.sub(ctx, "keys", rest, map[string]func(context.Context, []string) error{
+			"list":      c.keysList,
+			"rotate":    c.keysRotate,
+			"reencrypt": c.keysReencrypt,
+		})
+	default:
+		return usageError(fmt.Sprintf("unknown command %q, run walletctl -h", command))
+	}
+}
+
+func (c *cli) sub(ctx context.Context, command string, args []string, commands map[string]func(context.Context, []string) error) error {
+	if len(args) == 0 {
+		return usageError(fmt.Sprintf("%s: subcommand is required", command))
+	}
+	fn, ok := commands[args[0]]
+	if !ok {
+		return usageError(fmt.Sprintf("%s: unknown subcommand %q", command, args[0]))
+	}
+	return fn(ctx, args[1:])
+}
+
+// parse разбирает флаги команды name, ошибки флагов возвращаются как usageError.
+func parse(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse(args); err != nil {
+		return usageError(fmt.Sprintf("%s: %v", fs.Name(), err))
+	}
+	return nil
+}
+
+func userArg(fs *flag.FlagSet) (uuid.UUID, error) {
+	if fs.NArg() != 1 {
+		return uuid.Nil, usageError(fmt.Sprintf("%s: exactly one user id is required", fs.Name()))
+	}
+	id, err := uuid.Parse(fs.Arg(0))
+	if err != nil {
+		return uuid.Nil, usageError(fmt.Sprintf("%s: invalid user id %q", fs.Name(), fs.Arg(0)))
+	}
+	return id, nil
+}
+
+func (c *cli) usersFind(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("users find", flag.ContinueOnError)
+	limit := fs.Uint64("limit", 20, "maximum number of users")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return usageError("users find: exactly one search term is required")
+	}
+	users, err := c.env.Service.FindUsers(ctx, fs.Arg(0), *limit)
+	if err != nil {
+		return err
+	}
+	return c.out.print(users, "ID\tEMAIL\tUSERNAME\tVERIFIED\tCREATED\tCLOSED", func(tw *tabwriter.Writer) {
+		for _, u := range users {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", u.ID, u.Email, u.Username,
+				formatOptionalTime(u.EmailVerifiedAt), formatTime(u.CreatedAt), formatOptionalTime(u.ClosedAt))
+		}
+	})
+}
+
+func (c *cli) usersSetRole(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("users set-role", flag.ContinueOnError)
+	operator := fs.String("operator", os.Getenv("USER"), "who changes the role")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return usageError("users set-role: a user id and a role are required")
+	}
+	userID, err := uuid.Parse(fs.Arg(0))
+	if err != nil {
+		return usageError(fmt.Sprintf("users set-role: invalid user id %q", fs.Arg(0)))
+	}
+	role := user.Role(fs.Arg(1))
+	if err := c.env.Service.SetRole(ctx, userID, role, *operator); err != nil {
+		return err
+	}
+	return c.out.print(map[string]any{"user_id": userID, "role": role}, "USER\tROLE", func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "%s\t%s\n", userID, role)
+	})
+}
+
+func (c *cli) balances(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("balances", flag.ContinueOnError)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	userID, err := userArg(fs)
+	if err != nil {
+		return err
+	}
+	balances, err := c.env.Service.Balances(ctx, userID)
+	if err != nil {
+		return err
+	}
+	currencies := make([]string, 0, len(balances))
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+	return c.out.print(balances, "CURRENCY\tTOTAL\tHELD\tAVAILABLE", func(tw *tabwriter.Writer) {
+		for _, currency := range currencies {
+			b := balances[currency]
+			fmt.Fprintf(tw, "%s\t%.2f\t%.2f\t%.2f\n", currency, b.Total, b.Held, b.Available)
+		}
+	})
+}
+
+func (c *cli) transactions(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("transactions", flag.ContinueOnError)
+	currency := fs.String("currency", "", "only wallets in this currency")
+	limit := fs.Uint64("limit", 50, "maximum number of transactions")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	userID, err := userArg(fs)
+	if err != nil {
+		return err
+	}
+	list, err := c.env.Service.Transactions(ctx, userID, *currency, *limit)
+	if err != nil {
+		return err
+	}
+	return c.out.print(list, "ID\tCURRENCY\tTYPE\tAMOUNT\tREFERENCE\tCREATED", func(tw *tabwriter.Writer) {
+		for _, t := range list {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", t.ID, t.Currency, t.Type, formatCents(t.Amount),
+				formatOptional(t.Reference), formatTime(t.CreatedAt))
+		}
+	})
+}
+
+func (c *cli) adjust(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("adjust", flag.ContinueOnError)
+	currency := fs.String("currency", "", "wallet currency")
+	amount := fs.Float64("amount", 0, "signed amount, negative to debit")
+	reason := fs.String("reason", "", "why the adjustment is made, stored with it")
+	operator := fs.String("operator", os.Getenv("USER"), "who makes the adjustment")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	userID, err := userArg(fs)
+	if err != nil {
+		return err
+	}
+	adjustment, err := c.env.Service.Adjust(ctx, admin.AdjustRequest{
+		UserID:   userID,
+		Currency: *currency,
+		Amount:   float32(*amount),
+		Reason:   *reason,
+		Operator: *operator,
+	})
+	if err != nil {
+		return err
+	}
+	return c.out.print(adjustment, "ID\tCURRENCY\tAMOUNT\tBEFORE\tAFTER\tOPERATOR\tREASON", func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "%s\t%s\t%.2f\t%.2f\t%.2f\t%s\t%s\n", adjustment.ID, adjustment.Currency, adjustment.Amount,
+			adjustment.BalanceBefore, adjustment.BalanceAfter, adjustment.Operator, adjustment.Reason)
+	})
+}
+
+// outboxSelector разбирает общие флаги команд outbox: id событий позиционными аргументами, -status и -limit.
+func outboxSelector(name string, args []string) (events.Selector, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	status := fs.String("status", "", "event status: new, processed or done")
+	limit := fs.Uint64("limit", 100, "maximum number of events selected by status")
+	if err := parse(fs, args); err != nil {
+		return events.Selector{}, err
+	}
+	sel := events.Selector{Status: *status, Limit: *limit}
+	for _, arg := range fs.Args() {
+		id, err := uuid.Parse(arg)
+		if err != nil {
+			return events.Selector{}, usageError(fmt.Sprintf("%s: invalid event id %q", name, arg))
+		}
+		sel.IDs = append(sel.IDs, id)
+	}
+	if len(sel.IDs) > 0 {
+		// события, указанные по id, выбираются все
+		sel.Limit = 0
+	}
+	return sel, nil
+}
+
+func (c *cli) printEvents(list []*events.OutboxEvent) error {
+	return c.out.print(list, "ID\tTYPE\tSTATUS\tCREATED\tUPDATED", func(tw *tabwriter.Writer) {
+		for _, e := range list {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.ID, e.Type, e.Status, formatTime(e.CreatedAt), formatTime(e.UpdatedAt))
+		}
+	})
+}
+
+func (c *cli) outboxList(ctx context.Context, args []string) error {
+	sel, err := outboxSelector("outbox list", args)
+	if err != nil {
+		return err
+	}
+	list, err := c.env.Service.ListEvents(ctx, sel)
+	if err != nil {
+		return err
+	}
+	return c.printEvents(list)
+}
+
+func (c *cli) outboxRequeue(ctx context.Context, args []string) error {
+	sel, err := outboxSelector("outbox requeue", args)
+	if err != nil {
+		return err
+	}
+	list, err := c.env.Service.RequeueEvents(ctx, sel)
+	if err != nil {
+		return err
+	}
+	return c.printEvents(list)
+}
+
+func (c *cli) outboxReplay(ctx context.Context, args []string) error {
+	sel, err := outboxSelector("outbox replay", args)
+	if err != nil {
+		return err
+	}
+	publisher, kaf, err := c.env.Publisher(ctx)
+	if err != nil {
+		return err
+	}
+	defer kaf.Close()
+	list, err := c.env.Service.ReplayEvents(ctx, sel, publisher, c.env.Cfg.Kafka.Notification.Topic[0])
+	if printErr := c.printEvents(list); printErr != nil && err == nil {
+		err = printErr
+	}
+	return err
+}
+
+func (c *cli) reconcile(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	userID := uuid.Nil
+	if fs.NArg() > 0 {
+		var err error
+		if userID, err = userArg(fs); err != nil {
+			return err
+		}
+	}
+	mismatches, err := c.env.Service.Reconcile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	err = c.out.print(mismatches, "WALLET\tUSER\tCURRENCY\tBALANCE\tJOURNAL\tDIFFERENCE", func(tw *tabwriter.Writer) {
+		for _, m := range mismatches {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", m.WalletID, m.UserID, m.Currency,
+				formatCents(m.Balance), formatCents(m.Journal), formatCents(m.Difference()))
+		}
+	})
+	if err != nil {
+		return err
+	}
+	// ненулевой код выхода позволяет запускать сверку из cron и алертить по нему
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%d wallets do not reconcile", len(mismatches))
+	}
+	return nil
+}
+
+// keyView — ключ подписи для вывода, сам секрет не печатается.
+type keyView struct {
+	Kid         string     `json:"kid"`
+	State       string     `json:"state"`
+	ActivatesAt time.Time  `json:"activates_at"`
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`
+}
+
+func (c *cli) printKeys(keys []keyView) error {
+	return c.out.print(keys, "KID\tSTATE\tACTIVATES\tRETIRED", func(tw *tabwriter.Writer) {
+		for _, k := range keys {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", k.Kid, k.State, formatTime(k.ActivatesAt), formatOptionalTime(k.RetiredAt))
+		}
+	})
+}
+
+func (c *cli) keysList(ctx context.Context, args []string) error {
+	keys, err := c.env.Service.JWTKeys(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	views := make([]keyView, 0, len(keys))
+	for _, k := range keys {
+		views = append(views, keyView{Kid: k.Kid, State: k.State(now), ActivatesAt: k.ActivatesAt, RetiredAt: k.RetiredAt})
+	}
+	return c.printKeys(views)
+}
+
+func (c *cli) keysRotate(ctx context.Context, args []string) error {
+	key, err := c.env.Service.RotateJWTKey(ctx)
+	if err != nil {
+		return err
+	}
+	return c.printKeys([]keyView{{Kid: key.Kid, State: key.State(time.Now().UTC()), ActivatesAt: key.ActivatesAt}})
+}
+
+// keysReencrypt переводит секреты с текущего TOTP_ENCRYPTION_KEY на TOTP_ENCRYPTION_KEY_NEW.
+func (c *cli) keysReencrypt(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("keys reencrypt", flag.ContinueOnError)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	to, err := secretbox.New(os.Getenv("TOTP_ENCRYPTION_KEY_NEW"))
+	if err != nil {
+		return fmt.Errorf("TOTP_ENCRYPTION_KEY_NEW: %w", err)
+	}
+	result, err := c.env.Service.ReencryptSecrets(ctx, c.env.Box, to)
+	if err != nil {
+		return err
+	}
+	return c.out.print(result, "TOTP\tSIGNING KEYS", func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "%d\t%d\n", result.TOTP, result.SigningKeys)
+	})
+}
+
+func (c *cli) auditList(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("audit list", flag.ContinueOnError)
+	var req audit.ListRequest
+	fs.StringVar(&req.ActorID, "actor", "", "user who performed the action")
+	fs.StringVar(&req.TargetID, "target", "", "user whose account the action affected")
+	fs.StringVar(&req.Action, "action", "", "action, e.g. wallet.withdraw")
+	fs.StringVar(&req.From, "from", "", "period start in RFC 3339")
+	fs.StringVar(&req.To, "to", "", "period end in RFC 3339")
+	fs.Int64Var(&req.BeforeID, "before", 0, "only records older than this id")
+	fs.Uint64Var(&req.Limit, "limit", 50, "maximum number of records")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	records, err := c.env.Service.AuditLog(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.out.print(records, "ID\tACTION\tACTOR\tTARGET\tREQUEST\tIP\tBEFORE\tAFTER\tCREATED", func(tw *tabwriter.Writer) {
+		for _, r := range records {
+			target := "-"
+			if r.TargetID != nil {
+				target = r.TargetID.String()
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.ID, r.Action, r.Actor, target,
+				formatOptional(&r.RequestID), formatOptional(&r.IP), formatJSON(r.Before), formatJSON(r.After), formatTime(r.CreatedAt))
+		}
+	})
+}
+
+func (c *cli) auditVerify(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("audit verify", flag.ContinueOnError)
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	result, err := c.env.Service.VerifyAudit(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.out.print(result, "CHECKED\tBROKEN AT", func(tw *tabwriter.Writer) {
+		brokenAt := "-"
+		if !result.OK() {
+			brokenAt = fmt.Sprint(result.BrokenAt)
+		}
+		fmt.Fprintf(tw, "%d\t%s\n", result.Checked, brokenAt)
+	})
+	if err != nil {
+		return err
+	}
+	if !result.OK() {
+		return fmt.Errorf("audit chain is broken at record %d", result.BrokenAt)
+	}
+	return nil
+}
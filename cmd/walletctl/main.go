@@ -0,0 +1,92 @@
+// Command walletctl — операторский инструмент для работы с аккаунтами без psql. Читает тот же
+// конфиг, что и сервис (ENV_FILE, CONFIG_PATH), и подключается к той же базе.
+//
+//	walletctl [-o table|json] users find alice@example.com
+//	walletctl users set-role <user-id> admin
+//	walletctl balances <user-id>
+//	walletctl transactions -currency USD -limit 20 <user-id>
+//	walletctl adjust -currency USD -amount -10.50 -reason "duplicate payout" <user-id>
+//	walletctl outbox list -status new
+//	walletctl outbox requeue -status done -limit 50
+//	walletctl outbox replay <event-id>...
+//	walletctl reconcile [<user-id>]
+//	walletctl keys list
+//	walletctl keys rotate
+//	TOTP_ENCRYPTION_KEY_NEW=... walletctl keys reencrypt
+//	walletctl audit list -target <user-id> -action wallet.withdraw
+//	walletctl audit verify
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Sanchir01/currency-wallet/internal/app"
+)
+
+const usage = `usage: walletctl [-o table|json] <command> [flags] [args]
+
+commands:
+  users find <id|email|name>       find users, closed accounts included
+  users set-role <user-id> <role>  make a user admin or user again (-operator)
+  balances <user-id>               wallet balances with held and available amounts
+  transactions <user-id>           latest transactions (-currency, -limit)
+  adjust <user-id>                 manual balance adjustment (-currency, -amount, -reason, -operator)
+  outbox list                      outbox events (-status, -limit)
+  outbox requeue [<event-id>...]   send events again through the outbox worker (-status, -limit)
+  outbox replay [<event-id>...]    publish events to Kafka right now (-status, -limit)
+  reconcile [<user-id>]            wallets whose balance differs from the transaction journal
+  keys list                        JWT signing keys
+  keys rotate                      create a new JWT signing key
+  keys reencrypt                   re-encrypt TOTP secrets and JWT keys with TOTP_ENCRYPTION_KEY_NEW
+  audit list                       audit log, newest first (-actor, -target, -action, -from, -to, -before, -limit)
+  audit verify                     check the audit log hash chain
+`
+
+func main() {
+	output := flag.String("o", "table", "output format: table or json")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+	if *output != "table" && *output != "json" {
+		fmt.Fprintf(os.Stderr, "unknown output format %q\n", *output)
+		os.Exit(2)
+	}
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	// stdout занят выводом команд, логи идут в stderr
+	l := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	env, err := app.NewAdmin(ctx, l)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	cli := &cli{env: env, out: newPrinter(*output, os.Stdout)}
+	err = cli.run(ctx, flag.Args())
+	env.DB.Close()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		var usageErr usageError
+		if errors.As(err, &usageErr) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+// usageError — неверный вызов команды, завершается с кодом 2.
+type usageError string
+
+func (e usageError) Error() string {
+	return string(e)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// printer печатает результат команды таблицей для человека или JSON для скриптов.
+type printer struct {
+	json bool
+	w    io.Writer
+}
+
+func newPrinter(format string, w io.Writer) *printer {
+	return &printer{json: format == "json", w: w}
+}
+
+// print выводит v как JSON, а в табличном режиме вызывает table с заголовком header.
+func (p *printer) print(v any, header string, table func(tw *tabwriter.Writer)) error {
+	if p.json {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, header)
+	table(tw)
+	return tw.Flush()
+}
+
+// formatCents печатает сумму в копейках как десятичное число с двумя знаками.
+func formatCents(c int64) string {
+	sign := ""
+	if c < 0 {
+		sign, c = "-", -c
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, c/100, c%100)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return formatTime(*t)
+}
+
+func formatOptional(s *string) string {
+	if s == nil || *s == "" {
+		return "-"
+	}
+	return *s
+}
+
+func formatJSON(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return "-"
+	}
+	return string(raw)
+}
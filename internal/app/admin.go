@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/Sanchir01/currency-wallet/internal/feature/admin"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/events"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	kafkaclient "github.com/Sanchir01/currency-wallet/pkg/events"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+)
+
+// Admin — зависимости walletctl. Подключается только к Postgres: обменник, Redis и Kafka
+// операторским командам не нужны, брокер поднимается отдельно для replay.
+type Admin struct {
+	Cfg     *config.Config
+	Lg      *slog.Logger
+	DB      *db.Database
+	Repos   *Repository
+	Service *admin.Service
+	// Box — ключ TOTP_ENCRYPTION_KEY, которым зашифрованы TOTP-секреты и ключи подписи JWT
+	Box *secretbox.Box
+}
+
+// NewAdmin читает тот же конфиг, что и сервис. Логи пишутся в l, чтобы не смешиваться с выводом команд.
+func NewAdmin(ctx context.Context, l *slog.Logger) (*Admin, error) {
+	cfg := config.InitConfig()
+	pool, err := db.PGXNew(cfg, ctx)
+	if err != nil {
+		return nil, err
+	}
+	database := &db.Database{PrimaryDB: pool}
+	repo := NewRepository(database, l)
+	box, err := secretbox.New(os.Getenv("TOTP_ENCRYPTION_KEY"))
+	if err != nil {
+		database.Close()
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY: %w", err)
+	}
+	keys, err := NewKeyStore(ctx, repo, box)
+	if err != nil {
+		database.Close()
+		return nil, err
+	}
+	tx := db.NewTxManager(pool, l)
+	return &Admin{
+		Cfg:     cfg,
+		Lg:      l,
+		DB:      database,
+		Repos:   repo,
+		Service: admin.NewService(repo.AdminRepository, repo.WalletRepository, repo.EventRepository, audit.NewService(repo.AuditRepository, tx, l), keys, tx, l),
+		Box:     box,
+	}, nil
+}
+
+// Publisher подключается к Kafka и отправляет события так же, как воркер outbox.
+func (a *Admin) Publisher(ctx context.Context) (*events.Service, *kafkaclient.Producer, error) {
+	kaf, err := kafkaclient.NewProducer(a.Cfg.Kafka.Notification.Broke, a.Cfg.Kafka.Notification.Topic[0], a.Cfg.Kafka.Notification.Retries, ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events.NewEventService(a.Lg, a.Repos.EventRepository, kaf), kaf, nil
+}
@@ -2,14 +2,25 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/Sanchir01/currency-wallet/internal/feature/payment"
+	"github.com/Sanchir01/currency-wallet/internal/feature/rate"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
 	"github.com/Sanchir01/currency-wallet/pkg/db"
 	kafkaclient "github.com/Sanchir01/currency-wallet/pkg/events"
 	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/mailer"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
 	grpcapp "github.com/Sanchir01/currency-wallet/pkg/server/grpc"
 	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
 	"log/slog"
+	"os"
+	"strings"
+	"time"
 )
 
 type App struct {
@@ -19,26 +30,72 @@ type App struct {
 	Handlers *Handlers
 	Services *Services
 	Kafka    *kafkaclient.Producer
+	Keys     *user.KeyStore
+
+	RateLimiter *customiddleware.RateLimiter
 }
 
 func NewApp(ctx context.Context) (*App, error) {
 	cfg := config.InitConfig()
 	l := logger.SetupLogger(cfg.Env)
-	database, err := db.NewDataBases(cfg, ctx)
+	database, err := db.NewDataBases(cfg, ctx, l)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.DB.AutoMigrate {
+		if err := MigrateUp(ctx, database, l); err != nil {
+			return nil, fmt.Errorf("auto migrate: %w", err)
+		}
+	}
 	echangergrpcurl := fmt.Sprintf("%s:%s", cfg.GrpcClients.GRPCExchanger.Host, cfg.GrpcClients.GRPCExchanger.Port)
+	exchangerCfg := cfg.GrpcClients.GRPCExchanger
 	exchanger, err := grpcapp.NewClientGRPC(
 		l,
 		echangergrpcurl,
-		cfg.GrpcClients.GRPCExchanger.Timeout,
-		cfg.GrpcClients.GRPCExchanger.Retries,
+		grpcapp.ClientOptions{
+			Timeout:        exchangerCfg.Timeout,
+			AttemptTimeout: exchangerCfg.AttemptTimeout,
+			Retries:        exchangerCfg.Retries,
+			BackoffBase:    exchangerCfg.BackoffBase,
+			BackoffMax:     exchangerCfg.BackoffMax,
+			Breaker: grpcapp.BreakerOptions{
+				FailureThreshold: exchangerCfg.Breaker.FailureThreshold,
+				OpenTimeout:      exchangerCfg.Breaker.OpenTimeout,
+				HalfOpenMaxCalls: exchangerCfg.Breaker.HalfOpenMaxCalls,
+			},
+		},
 		walletsv1.NewExchangeServiceClient,
 	)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := NewRateProvider(cfg, exchanger, l)
+	if err != nil {
+		return nil, err
+	}
 	kaf, err := kafkaclient.NewProducer(cfg.Kafka.Notification.Broke, cfg.Kafka.Notification.Topic[0], cfg.Kafka.Notification.Retries, ctx)
 	repo := NewRepository(database, l)
-	srv := NewServices(repo, database, l, exchanger, kaf)
+	box, err := secretbox.New(os.Getenv("TOTP_ENCRYPTION_KEY"))
+	if err != nil {
+		return nil, fmt.Errorf("TOTP_ENCRYPTION_KEY: %w", err)
+	}
+	keys, err := NewKeyStore(ctx, repo, box)
+	if err != nil {
+		return nil, err
+	}
+	user.UseKeyStore(keys)
+	provider, err := NewPaymentProvider(cfg, l)
+	if err != nil {
+		return nil, err
+	}
+	srv := NewServices(repo, database, l, rates, kaf, NewMailer(cfg, l), cfg.Mailer.LinkBaseURL, user.TwoFactorOptions{
+		Box:              box,
+		Issuer:           cfg.TwoFactor.Issuer,
+		StepUpThresholds: cfg.TwoFactor.StepUpThresholds,
+	}, customiddleware.NewLoginLockout(database.RedisDB, cfg.RateLimit.Enabled, cfg.RateLimit.LoginLockout), cfg.LimitOrders.MaxTTL, wallet.HoldOptions{
+		DefaultTTL: cfg.Holds.DefaultTTL,
+		MaxTTL:     cfg.Holds.MaxTTL,
+	}, provider, cfg.Payments.DirectDeposit)
 	handlers := NewHandlers(srv, l)
 
 	return &App{
@@ -48,5 +105,113 @@ func NewApp(ctx context.Context) (*App, error) {
 		Handlers: handlers,
 		Services: srv,
 		Kafka:    kaf,
+		Keys:     keys,
+
+		RateLimiter: customiddleware.NewRateLimiter(database.RedisDB, cfg.RateLimit.Enabled, l),
 	}, nil
 }
+
+// MigrateUp накатывает встроенные миграции на основную базу.
+func MigrateUp(ctx context.Context, database *db.Database, l *slog.Logger) error {
+	migrator, err := db.NewMigrator(database.PrimaryDB, l)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+	return migrator.Up(ctx)
+}
+
+// NewKeyStore загружает ключи подписи JWT из БД.
+func NewKeyStore(ctx context.Context, repo *Repository, box *secretbox.Box) (*user.KeyStore, error) {
+	keys := user.NewKeyStore(repo.UserRepository, box)
+	if err := keys.Load(ctx); err != nil {
+		return nil, fmt.Errorf("load jwt signing keys: %w", err)
+	}
+	return keys, nil
+}
+
+func NewMailer(cfg *config.Config, l *slog.Logger) mailer.Mailer {
+	switch cfg.Mailer.Driver {
+	case "smtp":
+		return mailer.NewSMTPMailer(cfg.Mailer.Host, cfg.Mailer.Port, cfg.Mailer.Username,
+			os.Getenv("SMTP_PASSWORD"), cfg.Mailer.From)
+	default:
+		return mailer.NewLogMailer(l, cfg.Mailer.File)
+	}
+}
+
+// NewRateProvider собирает источники курсов из конфига. Без rates.providers курсы берутся только у gRPC-обменника.
+// Источники всегда оборачиваются в Aggregator: он приводит ошибки провайдеров к доменным.
+func NewRateProvider(cfg *config.Config, exchanger walletsv1.ExchangeServiceClient, l *slog.Logger) (rate.RateProvider, error) {
+	if len(cfg.Rates.Providers) == 0 {
+		return rate.NewAggregator([]rate.Source{{Provider: rate.NewGRPCProvider("exchanger", exchanger)}},
+			rate.AggregatorOptions{Mode: rate.ModeFailover, Base: cfg.Rates.Base}, l)
+	}
+	sources := make([]rate.Source, 0, len(cfg.Rates.Providers))
+	for _, p := range cfg.Rates.Providers {
+		var provider rate.RateProvider
+		switch p.Type {
+		case rate.GRPCProviderType:
+			provider = rate.NewGRPCProvider(p.Name, exchanger)
+		case rate.StaticProviderType:
+			if p.File == "" && len(p.Rates) == 0 {
+				return nil, fmt.Errorf("rate provider %q: file or rates is required", p.Name)
+			}
+			provider = rate.NewStaticProvider(p.Name, p.File, p.Rates)
+		case rate.HTTPProviderType:
+			if p.URL == "" {
+				return nil, fmt.Errorf("rate provider %q: url is required", p.Name)
+			}
+			timeout := p.Timeout
+			if timeout == 0 {
+				timeout = 5 * time.Second
+			}
+			provider = rate.NewHTTPProvider(p.Name, p.URL, timeout)
+		default:
+			return nil, fmt.Errorf("rate provider %q: unknown type %q", p.Name, p.Type)
+		}
+		sources = append(sources, rate.Source{Provider: provider, Priority: p.Priority, MaxAge: p.MaxAge})
+	}
+	return rate.NewAggregator(sources, rate.AggregatorOptions{
+		Mode:      cfg.Rates.Mode,
+		Base:      cfg.Rates.Base,
+		Tolerance: cfg.Rates.Tolerance,
+		Quorum:    cfg.Rates.Quorum,
+	}, l)
+}
+
+// minWebhookSecretBytes — более короткий секрет HMAC вебхуков можно подобрать и подделать зачисление.
+const minWebhookSecretBytes = 32
+
+// NewPaymentProvider собирает провайдера из конфига. Секрет подписи вебхуков берётся из PAYMENT_WEBHOOK_SECRET.
+func NewPaymentProvider(cfg *config.Config, l *slog.Logger) (payment.PaymentProvider, error) {
+	secret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if err := checkWebhookSecret(secret); err != nil {
+		return nil, fmt.Errorf("PAYMENT_WEBHOOK_SECRET: %w", err)
+	}
+	switch cfg.Payments.Provider {
+	case payment.FakeProviderName:
+		return payment.NewFakeProvider(payment.FakeOptions{
+			Secret:       []byte(secret),
+			CallbackURL:  cfg.Payments.Fake.CallbackURL,
+			ConfirmDelay: cfg.Payments.Fake.ConfirmDelay,
+			DeclineAbove: cfg.Payments.Fake.DeclineAbove,
+			Tolerance:    cfg.Payments.WebhookTolerance,
+		}, l), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.Payments.Provider)
+	}
+}
+
+// checkWebhookSecret отклоняет пустой, короткий и шаблонный секрет: с ним сервис не должен стартовать.
+func checkWebhookSecret(secret string) error {
+	switch lower := strings.ToLower(secret); {
+	case secret == "":
+		return errors.New("is not set")
+	case strings.Contains(lower, "change-me") || strings.Contains(lower, "changeme"):
+		return errors.New("is a placeholder, set a random value")
+	case len(secret) < minWebhookSecretBytes:
+		return fmt.Errorf("must be at least %d bytes", minWebhookSecretBytes)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckWebhookSecret(t *testing.T) {
+	cases := []struct {
+		name   string
+		secret string
+		ok     bool
+	}{
+		{"empty", "", false},
+		{"placeholder", "change-me-webhook-secret", false},
+		{"long placeholder", "CHANGEME-" + strings.Repeat("x", 40), false},
+		{"short", "s3cr3t-but-too-short", false},
+		{"random", "q6Jb0N1Jm5lVf4y8mM9cP2yV3tK7wZ0rH1sD5aE8uC4=", true},
+	}
+	for _, c := range cases {
+		if err := checkWebhookSecret(c.secret); (err == nil) != c.ok {
+			t.Errorf("%s: checkWebhookSecret(%q) = %v", c.name, c.secret, err)
+		}
+	}
+}
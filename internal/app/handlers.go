@@ -1,19 +1,37 @@
 package app
 
 import (
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/order"
+	"github.com/Sanchir01/currency-wallet/internal/feature/payment"
+	"github.com/Sanchir01/currency-wallet/internal/feature/rate"
+	"github.com/Sanchir01/currency-wallet/internal/feature/schedule"
+	"github.com/Sanchir01/currency-wallet/internal/feature/statement"
 	"github.com/Sanchir01/currency-wallet/internal/feature/user"
 	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
 	"log/slog"
 )
 
 type Handlers struct {
-	UserHandler   *user.Handler
-	WalletHandler *wallet.Handler
+	UserHandler      *user.Handler
+	WalletHandler    *wallet.Handler
+	ScheduleHandler  *schedule.Handler
+	OrderHandler     *order.Handler
+	PaymentHandler   *payment.Handler
+	StatementHandler *statement.Handler
+	RateHandler      *rate.Handler
+	AuditHandler     *audit.Handler
 }
 
 func NewHandlers(services *Services, log *slog.Logger) *Handlers {
 	return &Handlers{
-		UserHandler:   user.NewHandler(services.UserService, log),
-		WalletHandler: wallet.NewHandler(services.WalletService, log),
+		UserHandler:      user.NewHandler(services.UserService, log),
+		WalletHandler:    wallet.NewHandler(services.WalletService, log),
+		ScheduleHandler:  schedule.NewHandler(services.ScheduleService, log),
+		OrderHandler:     order.NewHandler(services.OrderService, log),
+		PaymentHandler:   payment.NewHandler(services.PaymentService, log),
+		StatementHandler: statement.NewHandler(services.StatementService, log),
+		RateHandler:      rate.NewHandler(services.RateService, log),
+		AuditHandler:     audit.NewHandler(services.AuditService, log),
 	}
 }
@@ -1,7 +1,14 @@
 package app
 
 import (
+	"github.com/Sanchir01/currency-wallet/internal/feature/admin"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
 	"github.com/Sanchir01/currency-wallet/internal/feature/events"
+	"github.com/Sanchir01/currency-wallet/internal/feature/order"
+	"github.com/Sanchir01/currency-wallet/internal/feature/payment"
+	"github.com/Sanchir01/currency-wallet/internal/feature/rate"
+	"github.com/Sanchir01/currency-wallet/internal/feature/schedule"
+	"github.com/Sanchir01/currency-wallet/internal/feature/statement"
 	"github.com/Sanchir01/currency-wallet/internal/feature/user"
 	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
 	"github.com/Sanchir01/currency-wallet/pkg/db"
@@ -9,15 +16,30 @@ import (
 )
 
 type Repository struct {
-	UserRepository   *user.Repository
-	WalletRepository *wallet.Repository
-	EventRepository  *events.Repository
+	UserRepository      *user.Repository
+	WalletRepository    *wallet.Repository
+	EventRepository     *events.Repository
+	ScheduleRepository  *schedule.Repository
+	OrderRepository     *order.Repository
+	PaymentRepository   *payment.Repository
+	StatementRepository *statement.Repository
+	RateRepository      *rate.Repository
+	AdminRepository     *admin.Repository
+	AuditRepository     *audit.Repository
 }
 
 func NewRepository(databases *db.Database, l *slog.Logger) *Repository {
+	reads := databases.ReadRouter()
 	return &Repository{
-		UserRepository:   user.NewRepository(databases.PrimaryDB),
-		WalletRepository: wallet.NewRepository(databases.PrimaryDB, l),
-		EventRepository:  events.NewRepository(databases.PrimaryDB),
+		UserRepository:      user.NewRepository(databases.PrimaryDB, reads),
+		WalletRepository:    wallet.NewRepository(databases.PrimaryDB, reads, l),
+		EventRepository:     events.NewRepository(databases.PrimaryDB),
+		ScheduleRepository:  schedule.NewRepository(databases.PrimaryDB),
+		OrderRepository:     order.NewRepository(databases.PrimaryDB),
+		PaymentRepository:   payment.NewRepository(databases.PrimaryDB),
+		StatementRepository: statement.NewRepository(databases.PrimaryDB),
+		RateRepository:      rate.NewRepository(databases.PrimaryDB),
+		AdminRepository:     admin.NewRepository(databases.PrimaryDB),
+		AuditRepository:     audit.NewRepository(databases.PrimaryDB),
 	}
 }
@@ -1,25 +1,63 @@
 package app
 
 import (
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
 	"github.com/Sanchir01/currency-wallet/internal/feature/events"
+	"github.com/Sanchir01/currency-wallet/internal/feature/order"
+	"github.com/Sanchir01/currency-wallet/internal/feature/payment"
+	"github.com/Sanchir01/currency-wallet/internal/feature/rate"
+	"github.com/Sanchir01/currency-wallet/internal/feature/schedule"
+	"github.com/Sanchir01/currency-wallet/internal/feature/statement"
 	"github.com/Sanchir01/currency-wallet/internal/feature/user"
 	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
 	"github.com/Sanchir01/currency-wallet/pkg/db"
 	kafkaclient "github.com/Sanchir01/currency-wallet/pkg/events"
-	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"github.com/Sanchir01/currency-wallet/pkg/mailer"
 	"log/slog"
+	"time"
 )
 
 type Services struct {
-	UserService   *user.Service
-	WalletService *wallet.Service
-	EventService  *events.Service
+	UserService      *user.Service
+	WalletService    *wallet.Service
+	EventService     *events.Service
+	ScheduleService  *schedule.Service
+	OrderService     *order.Service
+	PaymentService   *payment.Service
+	StatementService *statement.Service
+	RateService      *rate.Service
+	AuditService     *audit.Service
 }
 
-func NewServices(repos *Repository, db *db.Database, l *slog.Logger, exchanger walletsv1.ExchangeServiceClient, producer *kafkaclient.Producer) *Services {
+func NewServices(
+	repos *Repository,
+	database *db.Database,
+	l *slog.Logger,
+	rates rate.RateProvider,
+	producer *kafkaclient.Producer,
+	mail mailer.Mailer,
+	linkBaseURL string,
+	twoFactor user.TwoFactorOptions,
+	lockout user.LoginLockout,
+	orderMaxTTL time.Duration,
+	holds wallet.HoldOptions,
+	provider payment.PaymentProvider,
+	directDeposit bool,
+) *Services {
+	tx := db.NewTxManager(database.PrimaryDB, l)
+	auditService := audit.NewService(repos.AuditRepository, tx, l)
+	userService := user.NewService(repos.UserRepository, repos.WalletRepository, tx, mail, linkBaseURL, twoFactor, lockout, repos.EventRepository, auditService, l)
+	rateService := rate.NewService(repos.RateRepository, rates, tx, l)
+	walletService := wallet.NewService(repos.WalletRepository, repos.EventRepository, auditService, userService, tx, database.RedisDB, rates, rateService, holds, directDeposit, l)
 	return &Services{
-		UserService:   user.NewService(repos.UserRepository, repos.WalletRepository, db.PrimaryDB, l),
-		WalletService: wallet.NewService(repos.WalletRepository, repos.EventRepository, db.PrimaryDB, db.RedisDB, exchanger, l),
-		EventService:  events.NewEventService(l, repos.EventRepository, producer),
+		UserService:      userService,
+		WalletService:    walletService,
+		EventService:     events.NewEventService(l, repos.EventRepository, producer),
+		ScheduleService:  schedule.NewService(repos.ScheduleRepository, walletService, userService, repos.EventRepository, tx, l),
+		OrderService:     order.NewService(repos.OrderRepository, repos.WalletRepository, walletService, walletService, userService, repos.EventRepository, auditService, tx, orderMaxTTL, l),
+		PaymentService:   payment.NewService(repos.PaymentRepository, repos.WalletRepository, userService, repos.EventRepository, auditService, provider, tx, l),
+		StatementService: statement.NewService(repos.StatementRepository, tx, l),
+		RateService:      rateService,
+		AuditService:     auditService,
 	}
 }
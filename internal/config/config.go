@@ -20,6 +20,17 @@ type Config struct {
 	DB          DataBase    `yaml:"database"`
 	Prometheus  Prometheus  `yaml:"prometheus"`
 	Kafka       Kafka       `yaml:"kafka"`
+	Mailer      Mailer      `yaml:"mailer"`
+	TwoFactor   TwoFactor   `yaml:"two_factor"`
+	RateLimit   RateLimit   `yaml:"rate_limit"`
+	Scheduler   Scheduler   `yaml:"scheduler"`
+	LimitOrders LimitOrders `yaml:"limit_orders"`
+	Holds       Holds       `yaml:"holds"`
+	Payments    Payments    `yaml:"payments"`
+	Valuation   Valuation   `yaml:"valuation"`
+	RateHistory RateHistory `yaml:"rate_history"`
+	Audit       Audit       `yaml:"audit"`
+	Rates       Rates       `yaml:"rates"`
 }
 type Kafka struct {
 	Notification Producer `yaml:"notification"`
@@ -51,20 +62,151 @@ type DataBase struct {
 	Database    string `yaml:"dbname"`
 	SSL         string `yaml:"ssl"`
 	MaxAttempts int    `yaml:"max_attempts"`
+	// AutoMigrate накатывает встроенные миграции при старте сервиса
+	AutoMigrate bool `yaml:"auto_migrate" env:"DB_AUTO_MIGRATE" env-default:"false"`
+	// Replicas — реплики только для чтения, без них все запросы идут в основную базу
+	Replicas             []DataBaseReplica `yaml:"replicas"`
+	ReplicaCheckInterval time.Duration     `yaml:"replica_check_interval" env-default:"5s"`
+	// ReplicaMaxLag — реплика с большим отставанием не получает чтения до следующей проверки
+	ReplicaMaxLag time.Duration `yaml:"replica_max_lag" env-default:"2s"`
+	// ReadYourWritesWindow — сколько после изменения данных чтения пользователя идут в основную базу
+	ReadYourWritesWindow time.Duration `yaml:"read_your_writes_window" env-default:"5s"`
+}
+type DataBaseReplica struct {
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
 }
 type GrpcOrder struct {
 	Timeout int `yaml:"timeout"`
 	Port    int `yaml:"port"`
 }
 type GRPCExchanger struct {
-	Host    string        `yaml:"host"`
-	Port    string        `yaml:"port"`
-	Timeout time.Duration `yaml:"timeout"`
-	Retries int           `yaml:"retries"`
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
+	// Timeout — общий срок вызова вместе с повторами, должен укладываться в таймаут HTTP-сервера
+	Timeout time.Duration `yaml:"timeout" env-default:"2s"`
+	// AttemptTimeout — срок одной попытки
+	AttemptTimeout time.Duration `yaml:"attempt_timeout" env-default:"700ms"`
+	Retries        int           `yaml:"retries" env-default:"3"`
+	BackoffBase    time.Duration `yaml:"backoff_base" env-default:"50ms"`
+	BackoffMax     time.Duration `yaml:"backoff_max" env-default:"500ms"`
+	Breaker        GRPCBreaker   `yaml:"breaker"`
+}
+type GRPCBreaker struct {
+	// FailureThreshold — сколько неудачных вызовов подряд размыкают цепь
+	FailureThreshold int           `yaml:"failure_threshold" env-default:"5"`
+	OpenTimeout      time.Duration `yaml:"open_timeout" env-default:"30s"`
+	HalfOpenMaxCalls int           `yaml:"half_open_max_calls" env-default:"1"`
 }
 type GRPCClients struct {
 	GRPCExchanger GRPCExchanger `yaml:"grpc_exchanger"`
 }
+type Mailer struct {
+	Driver      string `yaml:"driver" env-default:"log"`
+	Host        string `yaml:"host"`
+	Port        string `yaml:"port" env-default:"587"`
+	Username    string `yaml:"username"`
+	From        string `yaml:"from"`
+	File        string `yaml:"file"`
+	LinkBaseURL string `yaml:"link_base_url" env-default:"http://localhost:5000"`
+}
+type TwoFactor struct {
+	Issuer string `yaml:"issuer" env-default:"Currency Wallet"`
+	// StepUpThresholds — с какой суммы в каждой валюте операция требует код второго фактора.
+	// Операция в валюте без порога требует код на любую сумму
+	StepUpThresholds map[string]float32 `yaml:"step_up_thresholds" env-default:"USD:1000,EUR:1000,RUB:100000"`
+}
+type RateLimit struct {
+	Enabled      bool                  `yaml:"enabled" env-default:"true"`
+	Routes       map[string]RouteLimit `yaml:"routes"`
+	LoginLockout LoginLockout          `yaml:"login_lockout"`
+}
+type RouteLimit struct {
+	Requests int           `yaml:"requests"`
+	Window   time.Duration `yaml:"window"`
+}
+type LoginLockout struct {
+	MaxFailures    int           `yaml:"max_failures" env-default:"5"`
+	BaseLockout    time.Duration `yaml:"base_lockout" env-default:"1m"`
+	MaxLockout     time.Duration `yaml:"max_lockout" env-default:"1h"`
+	FailuresWindow time.Duration `yaml:"failures_window" env-default:"24h"`
+}
+type Scheduler struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Interval  time.Duration `yaml:"interval" env-default:"30s"`
+	BatchSize uint64        `yaml:"batch_size" env-default:"50"`
+	// Lease — на сколько задача блокируется за воркером, после чего её может забрать другой экземпляр
+	Lease time.Duration `yaml:"lease" env-default:"2m"`
+}
+type LimitOrders struct {
+	Enabled   bool          `yaml:"enabled" env-default:"true"`
+	Interval  time.Duration `yaml:"interval" env-default:"5s"`
+	BatchSize uint64        `yaml:"batch_size" env-default:"100"`
+	// MaxTTL — максимальный срок жизни ордера
+	MaxTTL time.Duration `yaml:"max_ttl" env-default:"720h"`
+}
+
+type Holds struct {
+	Enabled bool `yaml:"enabled" env-default:"true"`
+	// DefaultTTL — срок холда, если клиент не передал expires_at
+	DefaultTTL     time.Duration `yaml:"default_ttl" env-default:"168h"`
+	MaxTTL         time.Duration `yaml:"max_ttl" env-default:"720h"`
+	ExpiryInterval time.Duration `yaml:"expiry_interval" env-default:"30s"`
+	BatchSize      uint64        `yaml:"batch_size" env-default:"100"`
+}
+type Payments struct {
+	Provider string `yaml:"provider" env-default:"fake"`
+	// DirectDeposit разрешает зачисление через /deposit без провайдера, только для разработки
+	DirectDeposit bool `yaml:"direct_deposit" env-default:"false"`
+	// WebhookTolerance — насколько старым может быть подписанный вебхук
+	WebhookTolerance time.Duration `yaml:"webhook_tolerance" env-default:"5m"`
+	Fake             FakeProvider  `yaml:"fake"`
+}
+type FakeProvider struct {
+	CallbackURL  string        `yaml:"callback_url" env-default:"http://localhost:5000/api/v1/payments/webhooks/fake"`
+	ConfirmDelay time.Duration `yaml:"confirm_delay" env-default:"2s"`
+	// DeclineAbove — суммы больше отклоняются, чтобы можно было проверить неуспешный сценарий
+	DeclineAbove float32 `yaml:"decline_above"`
+}
+type Valuation struct {
+	Enabled bool `yaml:"enabled" env-default:"true"`
+	// SnapshotInterval — как часто перезаписывается снимок балансов за текущий день
+	SnapshotInterval time.Duration `yaml:"snapshot_interval" env-default:"1h"`
+}
+type RateHistory struct {
+	Enabled bool `yaml:"enabled" env-default:"true"`
+	// Interval — как часто сохраняются курсы всех пар
+	Interval time.Duration `yaml:"interval" env-default:"1m"`
+}
+
+// Audit — писатель цепочки журнала аудита. Он работает всегда: без него записи остаются в очереди.
+type Audit struct {
+	// ChainInterval — как часто очередь встраивается в цепочку, столько же запись может не видна в журнале
+	ChainInterval time.Duration `yaml:"chain_interval" env-default:"1s"`
+}
+
+// Rates — источники курсов. Без providers используется только gRPC-обменник.
+type Rates struct {
+	// Mode — failover или consensus
+	Mode string `yaml:"mode" env-default:"failover"`
+	Base string `yaml:"base" env-default:"USD"`
+	// Tolerance — допустимое относительное отклонение от медианы в режиме consensus
+	Tolerance float64        `yaml:"tolerance" env-default:"0.02"`
+	Quorum    int            `yaml:"quorum" env-default:"2"`
+	Providers []RateProvider `yaml:"providers"`
+}
+type RateProvider struct {
+	Name string `yaml:"name"`
+	// Type — grpc, static или http
+	Type     string        `yaml:"type"`
+	Priority int           `yaml:"priority"`
+	MaxAge   time.Duration `yaml:"max_age"`
+	// File и Rates — источник курсов static: JSON-файл или значения прямо в конфиге
+	File    string             `yaml:"file"`
+	Rates   map[string]float64 `yaml:"rates"`
+	URL     string             `yaml:"url"`
+	Timeout time.Duration      `yaml:"timeout"`
+}
 type S3Store struct {
 	Key        string `yaml:"key"`
 	BucketName string `yaml:"bucketname"`
@@ -77,6 +219,8 @@ type HttpServer struct {
 	Port        string        `yaml:"port"  env-default:"5000"`
 	Debug       bool          `yaml:"debug"  env-default:"true"`
 	IdleTimeout time.Duration `yaml:"idle_timeout"  env-default:"60s"`
+	// MaxBodyBytes ограничивает размер тела любого запроса
+	MaxBodyBytes int64 `yaml:"max_body_bytes" env-default:"1048576"`
 }
 
 func InitConfig() *Config {
@@ -84,9 +228,9 @@ func InitConfig() *Config {
 	if envFile == "" {
 		envFile = ".env.dev"
 	}
-	fmt.Println("env name", envFile)
+	fmt.Fprintln(os.Stderr, "env name", envFile)
 	if err := godotenv.Load(envFile); err != nil {
-		slog.Error("ошибка при инициализации переменных окружения", err.Error())
+		slog.Error("ошибка при инициализации переменных окружения", slog.String("error", err.Error()))
 	}
 	configPath := os.Getenv("CONFIG_PATH")
 
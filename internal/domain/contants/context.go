@@ -1,5 +1,7 @@
 package contextkey
 
+import "slices"
+
 type ContextKey string
 
 const UserIDCtxKey ContextKey = "userID"
@@ -9,6 +11,10 @@ const ExchangeRateToCurrencyCtxKey string = "exchangeRateToCurrency"
 
 var Currencies = []string{"USD", "EUR", "RUB"}
 
+func IsKnownCurrency(currency string) bool {
+	return slices.Contains(Currencies, currency)
+}
+
 type OperationType string
 
 const (
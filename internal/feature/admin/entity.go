@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserSummary — пользователь в выдаче поиска оператора, включая закрытые аккаунты.
+type UserSummary struct {
+	ID              uuid.UUID  `json:"id"`
+	Email           string     `json:"email"`
+	Username        string     `json:"username"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ClosedAt        *time.Time `json:"closed_at,omitempty"`
+}
+
+// Transaction — операция по кошельку пользователя. Amount в копейках со знаком: списания отрицательные.
+// Переводы разделены на входящие и исходящие, как в выписке.
+type Transaction struct {
+	ID        uuid.UUID `json:"id"`
+	WalletID  uuid.UUID `json:"wallet_id"`
+	Currency  string    `json:"currency"`
+	Type      string    `json:"type"`
+	Amount    int64     `json:"amount_cents"`
+	Reference *string   `json:"reference,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AdjustRequest — ручная корректировка баланса. Amount со знаком: отрицательная сумма списывает.
+type AdjustRequest struct {
+	UserID   uuid.UUID
+	Currency string
+	Amount   float32
+	Reason   string
+	Operator string
+}
+
+// Adjustment — проведённая корректировка с остатками до и после.
+type Adjustment struct {
+	ID            uuid.UUID `json:"id"`
+	WalletID      uuid.UUID `json:"wallet_id"`
+	UserID        uuid.UUID `json:"user_id"`
+	Currency      string    `json:"currency"`
+	Amount        float32   `json:"amount"`
+	BalanceBefore float32   `json:"balance_before"`
+	BalanceAfter  float32   `json:"balance_after"`
+	Reason        string    `json:"reason"`
+	Operator      string    `json:"operator"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Mismatch — кошелёк, баланс которого не сходится с суммой операций журнала. Суммы в копейках.
+type Mismatch struct {
+	WalletID uuid.UUID `json:"wallet_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Currency string    `json:"currency"`
+	Balance  int64     `json:"balance_cents"`
+	Journal  int64     `json:"journal_cents"`
+}
+
+// Difference — на сколько баланс больше суммы операций журнала.
+func (m Mismatch) Difference() int64 {
+	return m.Balance - m.Journal
+}
+
+// Секреты, зашифрованные ключом TOTP_ENCRYPTION_KEY.
+const (
+	SecretTOTP       = "totp"
+	SecretSigningKey = "jwt_key"
+)
+
+// Secret — зашифрованный секрет: TOTP пользователя (ID — id пользователя) или ключ подписи JWT (ID — kid).
+type Secret struct {
+	Kind       string
+	ID         string
+	Ciphertext []byte
+}
+
+// Reencryption — сколько секретов перешифровано новым ключом.
+type Reencryption struct {
+	TOTP        int `json:"totp"`
+	SigningKeys int `json:"signing_keys"`
+}
@@ -0,0 +1,259 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+// delta — влияние операции на кошелёк w в копейках. Перевод пишется одной строкой
+// на кошелёк получателя, для отправителя (sender_wallet_id) это списание.
+const delta = `CASE WHEN t.type = 'WITHDRAW' OR (t.type = 'TRANSFER' AND t.sender_wallet_id = w.id)
+	THEN -(t.amount * 100)::BIGINT ELSE (t.amount * 100)::BIGINT END`
+
+// FindUsers ищет пользователей по id, точному email или подстроке email и имени.
+func (r *Repository) FindUsers(ctx context.Context, search string, limit uint64) ([]*UserSummary, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.
+		Select("id, email, username, email_verified_at, created_at, closed_at").
+		From("users").
+		OrderBy("created_at").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+	if id, err := uuid.Parse(search); err == nil {
+		builder = builder.Where(sq.Eq{"id": id})
+	} else {
+		pattern := "%" + search + "%"
+		builder = builder.Where(sq.Or{sq.ILike{"email": pattern}, sq.ILike{"username": pattern}})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := make([]*UserSummary, 0)
+	for rows.Next() {
+		var u UserSummary
+		if err := rows.Scan(&u.ID, &u.Email, &u.Username, &u.EmailVerifiedAt, &u.CreatedAt, &u.ClosedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, &u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Transactions возвращает последние операции по кошелькам пользователя, новые первыми.
+func (r *Repository) Transactions(ctx context.Context, userID uuid.UUID, currency string, limit uint64) ([]*Transaction, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.
+		Select(
+			"t.id",
+			"w.id",
+			"w.currency",
+			`CASE
+				WHEN t.type = 'TRANSFER' AND t.sender_wallet_id = w.id THEN 'TRANSFER_OUT'
+				WHEN t.type = 'TRANSFER' THEN 'TRANSFER_IN'
+				ELSE t.type::TEXT
+			END`,
+			delta,
+			"t.idempotency_key",
+			"t.created_at",
+		).
+		From("transactions t").
+		Join("wallets w ON t.wallet_id = w.id OR t.sender_wallet_id = w.id").
+		Where(sq.Eq{"w.user_id": userID}).
+		OrderBy("t.created_at DESC", "t.id").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+	if currency != "" {
+		builder = builder.Where(sq.Eq{"w.currency": currency})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	transactions := make([]*Transaction, 0)
+	for rows.Next() {
+		var t Transaction
+		if err := rows.Scan(&t.ID, &t.WalletID, &t.Currency, &t.Type, &t.Amount, &t.Reference, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// CreateAdjustment записывает корректировку в той же транзакции, что и изменение баланса.
+func (r *Repository) CreateAdjustment(ctx context.Context, a *Adjustment) (*Adjustment, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Insert("wallet_adjustments").
+		Columns("wallet_id", "user_id", "currency", "amount", "balance_before", "balance_after", "reason", "operator").
+		Values(a.WalletID, a.UserID, a.Currency, a.Amount, a.BalanceBefore, a.BalanceAfter, a.Reason, a.Operator).
+		Suffix("RETURNING id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	created := *a
+	if err := conn.QueryRow(ctx, query, args...).Scan(&created.ID, &created.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// SetRole меняет роль пользователя и возвращает прежнюю.
+func (r *Repository) SetRole(ctx context.Context, userID uuid.UUID, role user.Role) (user.Role, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	var previous user.Role
+	err := conn.QueryRow(ctx, `
+		UPDATE users u
+		SET role = $1
+		FROM (SELECT id, role FROM users WHERE id = $2 FOR UPDATE) old
+		WHERE u.id = old.id
+		RETURNING old.role`, role, userID).Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", utils.ErrorUserNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return previous, nil
+}
+
+// Reconcile сравнивает баланс каждого кошелька с суммой всех его операций в журнале
+// и возвращает только расходящиеся. userID == uuid.Nil проверяет все кошельки.
+func (r *Repository) Reconcile(ctx context.Context, userID uuid.UUID) ([]*Mismatch, error) {
+	conn, err := r.primaryDB.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Release()
+	// баланс и журнал читаются в одном снимке, иначе параллельная операция дала бы ложное расхождение
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	builder := sq.
+		Select(
+			"w.id",
+			"w.user_id",
+			"w.currency",
+			"(COALESCE(w.balance, 0) * 100)::BIGINT",
+			"COALESCE(SUM(d.delta), 0)::BIGINT",
+		).
+		From("wallets w").
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT `+delta+` AS delta
+			FROM transactions t
+			WHERE t.wallet_id = w.id OR t.sender_wallet_id = w.id
+		) d ON TRUE`).
+		GroupBy("w.id", "w.user_id", "w.currency", "w.balance").
+		Having("(COALESCE(w.balance, 0) * 100)::BIGINT <> COALESCE(SUM(d.delta), 0)::BIGINT").
+		OrderBy("w.user_id", "w.currency").
+		PlaceholderFormat(sq.Dollar)
+	if userID != uuid.Nil {
+		builder = builder.Where(sq.Eq{"w.user_id": userID})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	mismatches := make([]*Mismatch, 0)
+	for rows.Next() {
+		var m Mismatch
+		if err := rows.Scan(&m.WalletID, &m.UserID, &m.Currency, &m.Balance, &m.Journal); err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return mismatches, nil
+}
+
+// LockSecrets блокирует до конца транзакции из ctx и возвращает все секреты, зашифрованные TOTP_ENCRYPTION_KEY.
+func (r *Repository) LockSecrets(ctx context.Context) ([]*Secret, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]*Secret, 0)
+	for _, q := range []struct{ kind, query string }{
+		{SecretTOTP, "SELECT user_id::text, secret_encrypted FROM user_totp ORDER BY user_id FOR UPDATE"},
+		{SecretSigningKey, "SELECT kid, secret_encrypted FROM jwt_keys ORDER BY kid FOR UPDATE"},
+	} {
+		rows, err := tx.Query(ctx, q.query)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			secret := Secret{Kind: q.kind}
+			if err := rows.Scan(&secret.ID, &secret.Ciphertext); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			secrets = append(secrets, &secret)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+func (r *Repository) UpdateSecret(ctx context.Context, secret *Secret) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.Update("user_totp").Where(sq.Expr("user_id::text = ?", secret.ID))
+	if secret.Kind == SecretSigningKey {
+		builder = sq.Update("jwt_keys").Where(sq.Eq{"kid": secret.ID})
+	}
+	query, args, err := builder.
+		Set("secret_encrypted", secret.Ciphertext).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,327 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/events"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type ServiceAdmin interface {
+	FindUsers(ctx context.Context, search string, limit uint64) ([]*UserSummary, error)
+	Transactions(ctx context.Context, userID uuid.UUID, currency string, limit uint64) ([]*Transaction, error)
+	CreateAdjustment(ctx context.Context, a *Adjustment) (*Adjustment, error)
+	SetRole(ctx context.Context, userID uuid.UUID, role user.Role) (user.Role, error)
+	Reconcile(ctx context.Context, userID uuid.UUID) ([]*Mismatch, error)
+	LockSecrets(ctx context.Context) ([]*Secret, error)
+	UpdateSecret(ctx context.Context, secret *Secret) error
+}
+type ServiceWallets interface {
+	DepositOrWithdrawBalance(
+		ctx context.Context,
+		id uuid.UUID,
+		amount float32,
+		currency string,
+		typedepo contextkey.OperationType,
+	) (*models.CurrencyWalletDB, error)
+	SetTransaction(
+		ctx context.Context,
+		walletID uuid.UUID,
+		amount float32,
+		typetransaction contextkey.OperationType,
+		senderID *uuid.UUID,
+		idempotencyKey string,
+	) error
+	BalanceDetails(ctx context.Context, id uuid.UUID) (map[string]wallet.Balance, error)
+}
+type ServiceEvents interface {
+	FindEvents(ctx context.Context, sel events.Selector) ([]*events.OutboxEvent, error)
+	Requeue(ctx context.Context, ids []uuid.UUID) (int64, error)
+	SetDone(ctx context.Context, ids []uuid.UUID) error
+}
+
+type ServiceAudit interface {
+	Record(ctx context.Context, e audit.Entry) error
+	List(ctx context.Context, req audit.ListRequest) ([]*audit.Record, error)
+	Verify(ctx context.Context) (*audit.Verification, error)
+}
+
+// EventPublisher отправляет событие outbox в брокер, как это делает воркер outbox.
+type EventPublisher interface {
+	SendMessage(event *events.EventDB, topic string) error
+}
+
+// Service — операторские действия над аккаунтами, кошельками и outbox. Используется walletctl.
+type Service struct {
+	repository ServiceAdmin
+	wallets    ServiceWallets
+	events     ServiceEvents
+	audit      ServiceAudit
+	keys       *user.KeyStore
+	tx         *db.TxManager
+	log        *slog.Logger
+}
+
+func NewService(r ServiceAdmin, wallets ServiceWallets, events ServiceEvents, audit ServiceAudit, keys *user.KeyStore, tx *db.TxManager, log *slog.Logger) *Service {
+	return &Service{
+		repository: r,
+		wallets:    wallets,
+		events:     events,
+		audit:      audit,
+		keys:       keys,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+func (s *Service) FindUsers(ctx context.Context, search string, limit uint64) ([]*UserSummary, error) {
+	return s.repository.FindUsers(ctx, strings.TrimSpace(search), limit)
+}
+
+func (s *Service) Balances(ctx context.Context, userID uuid.UUID) (map[string]wallet.Balance, error) {
+	return s.wallets.BalanceDetails(ctx, userID)
+}
+
+func (s *Service) Transactions(ctx context.Context, userID uuid.UUID, currency string, limit uint64) ([]*Transaction, error) {
+	if currency != "" && !contextkey.IsKnownCurrency(currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	return s.repository.Transactions(ctx, userID, currency, limit)
+}
+
+// Adjust проводит ручную корректировку: меняет баланс, пишет операцию в журнал и запись
+// в wallet_adjustments в одной транзакции. Списание не может уйти ниже суммы под холдами.
+func (s *Service) Adjust(ctx context.Context, req AdjustRequest) (_ *Adjustment, err error) {
+	const op = "Admin.Service.Adjust"
+	log := s.log.With(slog.String("op", op))
+
+	req.Reason = strings.TrimSpace(req.Reason)
+	req.Operator = strings.TrimSpace(req.Operator)
+	if req.Amount == 0 || req.Reason == "" || req.Operator == "" {
+		return nil, utils.ErrorAdjustmentInvalid
+	}
+	if !contextkey.IsKnownCurrency(req.Currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	operation, amount := contextkey.OperationTypeDeposit, req.Amount
+	if amount < 0 {
+		operation, amount = contextkey.OperationTypeWithdraw, -amount
+	}
+	var adjustment *Adjustment
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		data, err := s.wallets.DepositOrWithdrawBalance(ctx, req.UserID, amount, req.Currency, operation)
+		if err != nil {
+			return err
+		}
+		after := data.Balances[req.Currency]
+		adjustment, err = s.repository.CreateAdjustment(ctx, &Adjustment{
+			WalletID:      data.WalletID,
+			UserID:        req.UserID,
+			Currency:      req.Currency,
+			Amount:        req.Amount,
+			BalanceBefore: after - req.Amount,
+			BalanceAfter:  after,
+			Reason:        req.Reason,
+			Operator:      req.Operator,
+		})
+		if err != nil {
+			return err
+		}
+		if err = s.wallets.SetTransaction(ctx, data.WalletID, amount, operation, nil, "adjustment:"+adjustment.ID.String()); err != nil {
+			return err
+		}
+		if err = s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionAdjustment,
+			Actor:    req.Operator,
+			TargetID: &req.UserID,
+			Before:   map[string]float32{req.Currency: adjustment.BalanceBefore},
+			After:    map[string]float32{req.Currency: adjustment.BalanceAfter},
+		}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("balance adjusted",
+		slog.String("adjustment_id", adjustment.ID.String()),
+		slog.String("user_id", req.UserID.String()),
+		slog.String("currency", req.Currency),
+		slog.Any("amount", req.Amount),
+		slog.String("operator", req.Operator),
+		slog.String("reason", req.Reason),
+	)
+	return adjustment, nil
+}
+
+// SetRole меняет роль пользователя и пишет смену в журнал аудита в той же транзакции.
+func (s *Service) SetRole(ctx context.Context, userID uuid.UUID, role user.Role, operator string) (err error) {
+	operator = strings.TrimSpace(operator)
+	if !role.Valid() || operator == "" {
+		return utils.ErrorRoleInvalid
+	}
+	var previous user.Role
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		previous, err = s.repository.SetRole(ctx, userID, role)
+		if err != nil {
+			return err
+		}
+		if err = s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionRoleChange,
+			Actor:    operator,
+			TargetID: &userID,
+			Before:   map[string]user.Role{"role": previous},
+			After:    map[string]user.Role{"role": role},
+		}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.log.Info("user role changed",
+		slog.String("user_id", userID.String()),
+		slog.String("from", string(previous)),
+		slog.String("to", string(role)),
+		slog.String("operator", operator),
+	)
+	return nil
+}
+
+func (s *Service) AuditLog(ctx context.Context, req audit.ListRequest) ([]*audit.Record, error) {
+	return s.audit.List(ctx, req)
+}
+
+// VerifyAudit пересчитывает цепочку хешей журнала аудита.
+func (s *Service) VerifyAudit(ctx context.Context) (*audit.Verification, error) {
+	return s.audit.Verify(ctx)
+}
+
+func (s *Service) ListEvents(ctx context.Context, sel events.Selector) ([]*events.OutboxEvent, error) {
+	return s.events.FindEvents(ctx, sel)
+}
+
+// RequeueEvents возвращает выбранные события в очередь воркера outbox.
+func (s *Service) RequeueEvents(ctx context.Context, sel events.Selector) ([]*events.OutboxEvent, error) {
+	selected, err := s.selectEvents(ctx, sel)
+	if err != nil || len(selected) == 0 {
+		return selected, err
+	}
+	if _, err := s.events.Requeue(ctx, eventIDs(selected)); err != nil {
+		return nil, err
+	}
+	s.log.Info("outbox events requeued", slog.Int("count", len(selected)))
+	return selected, nil
+}
+
+// ReplayEvents сразу отправляет выбранные события в брокер и помечает отправленные как done.
+// Отправка останавливается на первой ошибке, уже отправленные события остаются помеченными.
+func (s *Service) ReplayEvents(ctx context.Context, sel events.Selector, publisher EventPublisher, topic string) ([]*events.OutboxEvent, error) {
+	selected, err := s.selectEvents(ctx, sel)
+	if err != nil {
+		return nil, err
+	}
+	sent := make([]*events.OutboxEvent, 0, len(selected))
+	for _, e := range selected {
+		if err = publisher.SendMessage(&e.EventDB, topic); err != nil {
+			break
+		}
+		sent = append(sent, e)
+	}
+	if len(sent) > 0 {
+		if doneErr := s.events.SetDone(ctx, eventIDs(sent)); doneErr != nil {
+			return sent, errors.Join(err, doneErr)
+		}
+		s.log.Info("outbox events replayed", slog.Int("count", len(sent)))
+	}
+	return sent, err
+}
+
+// selectEvents не даёт выбрать весь outbox без фильтра.
+func (s *Service) selectEvents(ctx context.Context, sel events.Selector) ([]*events.OutboxEvent, error) {
+	if len(sel.IDs) == 0 && sel.Status == "" {
+		return nil, utils.ErrorOutboxSelectorMissing
+	}
+	return s.events.FindEvents(ctx, sel)
+}
+
+func eventIDs(list []*events.OutboxEvent) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(list))
+	for _, e := range list {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// Reconcile возвращает кошельки, баланс которых расходится с журналом операций.
+func (s *Service) Reconcile(ctx context.Context, userID uuid.UUID) ([]*Mismatch, error) {
+	return s.repository.Reconcile(ctx, userID)
+}
+
+// RotateJWTKey создаёт новый ключ подписи, экземпляры сервиса начнут подписывать им через user.KeyRotationDelay.
+func (s *Service) RotateJWTKey(ctx context.Context) (*user.SigningKey, error) {
+	key, err := s.keys.Rotate(ctx, user.KeyRotationDelay)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("jwt signing key rotated", slog.String("kid", key.Kid), slog.Time("activates_at", key.ActivatesAt))
+	return key, nil
+}
+
+// ReencryptSecrets перешифровывает TOTP-секреты пользователей и ключи подписи JWT ключом to. Всё делается
+// одной транзакцией: если хоть один секрет не открывается ключом from, ничего не меняется. После неё сервис
+// нужно перезапустить с новым TOTP_ENCRYPTION_KEY, старый ключ больше ничего не открывает.
+func (s *Service) ReencryptSecrets(ctx context.Context, from, to *secretbox.Box) (*Reencryption, error) {
+	var result Reencryption
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		result = Reencryption{}
+		secrets, err := s.repository.LockSecrets(ctx)
+		if err != nil {
+			return err
+		}
+		for _, secret := range secrets {
+			plaintext, err := from.Open(secret.Ciphertext)
+			if err != nil {
+				return fmt.Errorf("decrypt %s %s: %w", secret.Kind, secret.ID, err)
+			}
+			if secret.Ciphertext, err = to.Seal(plaintext); err != nil {
+				return err
+			}
+			if err := s.repository.UpdateSecret(ctx, secret); err != nil {
+				return err
+			}
+			if secret.Kind == SecretTOTP {
+				result.TOTP++
+			} else {
+				result.SigningKeys++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("secrets re-encrypted", slog.Int("totp", result.TOTP), slog.Int("signing_keys", result.SigningKeys))
+	return &result, nil
+}
+
+func (s *Service) JWTKeys(ctx context.Context) ([]user.SigningKey, error) {
+	if err := s.keys.Load(ctx); err != nil {
+		return nil, err
+	}
+	return s.keys.Keys(), nil
+}
@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/google/uuid"
+)
+
+// Action — вид действия в журнале аудита.
+type Action string
+
+const (
+	ActionRegister     Action = "user.register"
+	ActionLogin        Action = "user.login"
+	ActionDeposit      Action = "wallet.deposit"
+	ActionWithdraw     Action = "wallet.withdraw"
+	ActionExchange     Action = "wallet.exchange"
+	ActionTransfer     Action = "wallet.transfer"
+	ActionHoldCapture  Action = "wallet.hold_capture"
+	ActionHoldVoid     Action = "wallet.hold_void"
+	ActionHoldExpire   Action = "wallet.hold_expire"
+	ActionTopUp        Action = "payment.topup"
+	ActionPayout       Action = "payment.payout"
+	ActionRefund       Action = "payment.refund"
+	ActionOrderExecute Action = "order.execute"
+	ActionAdjustment   Action = "admin.adjustment"
+	ActionRoleChange   Action = "admin.role_change"
+)
+
+// ActorSystem — исполнитель действий, которые запустил не пользователь и не оператор: планировщик, воркеры.
+const ActorSystem = "system"
+
+// Entry — действие для записи в журнал. ActorID задаётся, когда действие выполнил сам пользователь,
+// Actor — имя оператора walletctl. Before и After сериализуются в JSON.
+type Entry struct {
+	Action   Action
+	ActorID  *uuid.UUID
+	Actor    string
+	TargetID *uuid.UUID
+	Before   any
+	After    any
+}
+
+// Record — запись журнала. Hash считается от PrevHash и содержимого записи, см. Service.hash.
+type Record struct {
+	ID        int64           `json:"id"`
+	Action    Action          `json:"action"`
+	ActorID   *uuid.UUID      `json:"actor_id,omitempty"`
+	Actor     string          `json:"actor"`
+	TargetID  *uuid.UUID      `json:"target_id,omitempty"`
+	RequestID string          `json:"request_id,omitempty"`
+	IP        string          `json:"ip,omitempty"`
+	Before    json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After     json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	CreatedAt time.Time       `json:"created_at"`
+	PrevHash  []byte          `json:"prev_hash" swaggertype:"string" format:"base64"`
+	Hash      []byte          `json:"hash" swaggertype:"string" format:"base64"`
+}
+
+// Meta — данные запроса, в рамках которого выполняется действие.
+type Meta struct {
+	RequestID string
+	IP        string
+}
+
+type metaKey struct{}
+
+// WithMeta кладёт в контекст данные запроса для записей аудита.
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, metaKey{}, meta)
+}
+
+// MetaFromContext возвращает данные запроса, вне HTTP-запроса они пустые.
+func MetaFromContext(ctx context.Context) Meta {
+	meta, _ := ctx.Value(metaKey{}).(Meta)
+	return meta
+}
+
+// defaultListLimit — сколько записей отдаётся, если limit не задан.
+const defaultListLimit = 50
+
+// ListRequest — фильтры выборки журнала. Записи отдаются от новых к старым,
+// BeforeID продолжает выборку с записи старше указанной.
+type ListRequest struct {
+	ActorID  string `json:"actor_id" validate:"omitempty,uuid"`
+	TargetID string `json:"target_id" validate:"omitempty,uuid"`
+	Action   string `json:"action" validate:"omitempty,max=64"`
+	From     string `json:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To       string `json:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	BeforeID int64  `json:"before_id" validate:"min=0"`
+	Limit    uint64 `json:"limit" validate:"max=500"`
+}
+
+// Filter — разобранные фильтры ListRequest для репозитория.
+type Filter struct {
+	ActorID  *uuid.UUID
+	TargetID *uuid.UUID
+	Action   string
+	From     *time.Time
+	To       *time.Time
+	BeforeID int64
+	Limit    uint64
+}
+
+type ListResponse struct {
+	api.Response
+	Records []*Record `json:"records"`
+}
+
+// Verification — результат проверки цепочки хешей. BrokenAt — первая запись, на которой
+// цепочка не сходится: её хеш не совпадает с содержимым или prev_hash не равен хешу предыдущей.
+type Verification struct {
+	Checked  int64 `json:"checked"`
+	BrokenAt int64 `json:"broken_at,omitempty"`
+}
+
+func (v *Verification) OK() bool {
+	return v.BrokenAt == 0
+}
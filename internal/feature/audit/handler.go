@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+)
+
+type HandlerAudit interface {
+	List(ctx context.Context, req ListRequest) ([]*Record, error)
+}
+
+type Handler struct {
+	s   HandlerAudit
+	log *slog.Logger
+}
+
+func NewHandler(s HandlerAudit, log *slog.Logger) *Handler {
+	return &Handler{
+		s:   s,
+		log: log,
+	}
+}
+
+// @Summary ListAuditLog
+// @ID listAuditLog
+// @Tags admin
+// @Description audit log records, newest first. Only for administrators. To get the next page pass
+// @Description the id of the last received record as before_id
+// @Produce json
+// @Param actor_id query string false "user who performed the action"
+// @Param target_id query string false "user whose account the action affected"
+// @Param action query string false "action" Enums(user.register, user.login, wallet.deposit, wallet.withdraw, wallet.exchange, wallet.transfer, wallet.hold_capture, wallet.hold_void, wallet.hold_expire, payment.topup, payment.payout, payment.refund, order.execute, admin.adjustment, admin.role_change)
+// @Param from query string false "period start in RFC 3339"
+// @Param to query string false "period end in RFC 3339, exclusive"
+// @Param before_id query int false "return records older than this id"
+// @Param limit query int false "page size, 50 by default, at most 500"
+// @Success 200 {object}  ListResponse
+// @Failure 400,401,403 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /admin/audit [get]
+func (h *Handler) ListHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Audit.Handler.List"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	query := r.URL.Query()
+	req := ListRequest{
+		ActorID:  query.Get("actor_id"),
+		TargetID: query.Get("target_id"),
+		Action:   query.Get("action"),
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+	}
+	var err error
+	if value := query.Get("before_id"); value != "" {
+		if req.BeforeID, err = strconv.ParseInt(value, 10, 64); err != nil {
+			api.WriteError(w, r, utils.ErrorAuditFilterInvalid)
+			return
+		}
+	}
+	if value := query.Get("limit"); value != "" {
+		if req.Limit, err = strconv.ParseUint(value, 10, 64); err != nil {
+			api.WriteError(w, r, utils.ErrorAuditFilterInvalid)
+			return
+		}
+	}
+	if err := request.Validate(&req); err != nil {
+		api.WriteError(w, r, err)
+		return
+	}
+	records, err := h.s.List(r.Context(), req)
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to list audit log", logger.Err(err))
+		}
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, ListResponse{
+		Response: api.OK(),
+		Records:  records,
+	})
+}
@@ -0,0 +1,217 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+const recordColumns = "id, action, actor_id, actor, target_id, request_id, ip, before, after, created_at, prev_hash, hash"
+
+// LastHash берёт блокировку цепочки до конца транзакции из ctx и возвращает хеш последней записи.
+// Пока блокировка держится, другие экземпляры не могут дописать журнал, поэтому цепочка не ветвится.
+// Блокировку берёт только Service.Flush, операции пользователей её не ждут.
+func (r *Repository) LastHash(ctx context.Context) ([]byte, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('audit_log'))"); err != nil {
+		return nil, err
+	}
+	var hash []byte
+	err = tx.QueryRow(ctx, "SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1").Scan(&hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return []byte{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// queueColumns — колонки audit_queue: запись без id журнала и хешей.
+const queueColumns = "id, action, actor_id, actor, target_id, request_id, ip, before, after, created_at"
+
+// Enqueue ставит запись в очередь журнала в транзакции из ctx.
+func (r *Repository) Enqueue(ctx context.Context, rec *Record) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Insert("audit_queue").
+		Columns("action", "actor_id", "actor", "target_id", "request_id", "ip", "before", "after", "created_at").
+		Values(rec.Action, rec.ActorID, rec.Actor, rec.TargetID, rec.RequestID, rec.IP, rec.Before, rec.After, rec.CreatedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, args...)
+	return err
+}
+
+// Queued возвращает до limit записей очереди в порядке постановки. ID записей — id в очереди.
+func (r *Repository) Queued(ctx context.Context, limit uint64) ([]*Record, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Select(queueColumns).
+		From("audit_queue").
+		OrderBy("id").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records := make([]*Record, 0)
+	for rows.Next() {
+		var rec Record
+		if err := rows.Scan(&rec.ID, &rec.Action, &rec.ActorID, &rec.Actor, &rec.TargetID, &rec.RequestID, &rec.IP,
+			&rec.Before, &rec.After, &rec.CreatedAt); err != nil {
+			return nil, err
+		}
+		records = append(records, &rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Dequeue удаляет из очереди записи, уже встроенные в цепочку. Триггер audit_queue разрешает удалить
+// только запись, на которую ссылается audit_log.queue_id.
+func (r *Repository) Dequeue(ctx context.Context, ids []int64) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Delete("audit_queue").
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, args...)
+	return err
+}
+
+// Insert дописывает запись в цепочку. queueID — id записи в audit_queue, из которой она перенесена.
+func (r *Repository) Insert(ctx context.Context, rec *Record, queueID int64) (int64, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Insert("audit_log").
+		Columns("action", "actor_id", "actor", "target_id", "request_id", "ip", "before", "after", "created_at", "prev_hash", "hash", "queue_id").
+		Values(rec.Action, rec.ActorID, rec.Actor, rec.TargetID, rec.RequestID, rec.IP, rec.Before, rec.After, rec.CreatedAt, rec.PrevHash, rec.Hash, queueID).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, utils.ErrorQueryString
+	}
+	var id int64
+	if err := conn.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// List возвращает записи по фильтру f, новые первыми.
+func (r *Repository) List(ctx context.Context, f Filter) ([]*Record, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.
+		Select(recordColumns).
+		From("audit_log").
+		OrderBy("id DESC").
+		Limit(f.Limit).
+		PlaceholderFormat(sq.Dollar)
+	if f.ActorID != nil {
+		builder = builder.Where(sq.Eq{"actor_id": *f.ActorID})
+	}
+	if f.TargetID != nil {
+		builder = builder.Where(sq.Eq{"target_id": *f.TargetID})
+	}
+	if f.Action != "" {
+		builder = builder.Where(sq.Eq{"action": f.Action})
+	}
+	if f.From != nil {
+		builder = builder.Where(sq.GtOrEq{"created_at": *f.From})
+	}
+	if f.To != nil {
+		builder = builder.Where(sq.Lt{"created_at": *f.To})
+	}
+	if f.BeforeID > 0 {
+		builder = builder.Where(sq.Lt{"id": f.BeforeID})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records := make([]*Record, 0)
+	for rows.Next() {
+		rec, err := scanRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Walk передаёт fn все записи по порядку цепочки. Журнал читается одним снимком,
+// записи, добавленные во время обхода, в него не попадают.
+func (r *Repository) Walk(ctx context.Context, fn func(*Record) error) error {
+	conn, err := r.primaryDB.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	rows, err := tx.Query(ctx, "SELECT "+recordColumns+" FROM audit_log ORDER BY id")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rec, err := scanRecord(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func scanRecord(rows pgx.Rows) (*Record, error) {
+	var rec Record
+	if err := rows.Scan(&rec.ID, &rec.Action, &rec.ActorID, &rec.Actor, &rec.TargetID, &rec.RequestID, &rec.IP,
+		&rec.Before, &rec.After, &rec.CreatedAt, &rec.PrevHash, &rec.Hash); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
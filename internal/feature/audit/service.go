@@ -0,0 +1,282 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type ServiceAudit interface {
+	Enqueue(ctx context.Context, rec *Record) error
+	Queued(ctx context.Context, limit uint64) ([]*Record, error)
+	Dequeue(ctx context.Context, ids []int64) error
+	LastHash(ctx context.Context) ([]byte, error)
+	Insert(ctx context.Context, rec *Record, queueID int64) (int64, error)
+	List(ctx context.Context, f Filter) ([]*Record, error)
+	Walk(ctx context.Context, fn func(*Record) error) error
+}
+
+type Service struct {
+	repository ServiceAudit
+	tx         *db.TxManager
+	log        *slog.Logger
+}
+
+func NewService(r ServiceAudit, tx *db.TxManager, log *slog.Logger) *Service {
+	return &Service{repository: r, tx: tx, log: log}
+}
+
+// flushBatch — сколько записей очереди Flush встраивает в цепочку одной транзакцией.
+const flushBatch = 500
+
+// Record ставит действие в очередь журнала в транзакции из ctx, вместе с самим действием: откат действия
+// убирает и запись. Блокировок Record не берёт, в цепочку запись встраивает Flush, поэтому операции
+// разных пользователей не ждут друг друга.
+func (s *Service) Record(ctx context.Context, e Entry) error {
+	meta := MetaFromContext(ctx)
+	rec := &Record{
+		Action:    e.Action,
+		ActorID:   e.ActorID,
+		Actor:     e.Actor,
+		TargetID:  e.TargetID,
+		RequestID: meta.RequestID,
+		IP:        meta.IP,
+		// TIMESTAMP хранит микросекунды, хеш считается от того же значения, что прочитается из базы
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	if rec.Actor == "" {
+		rec.Actor = ActorSystem
+		if e.ActorID != nil {
+			rec.Actor = e.ActorID.String()
+		}
+	}
+	var err error
+	if rec.Before, err = marshal(e.Before); err != nil {
+		return err
+	}
+	if rec.After, err = marshal(e.After); err != nil {
+		return err
+	}
+	if err := s.repository.Enqueue(ctx, rec); err != nil {
+		s.log.Error("failed to write audit record", slog.String("action", string(e.Action)), slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// Flush встраивает всю очередь в цепочку и возвращает число записей. Цепочку в каждый момент
+// дописывает один экземпляр сервиса: остальные ждут блокировку LastHash.
+func (s *Service) Flush(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, err := s.chain(ctx, flushBatch)
+		total += n
+		if err != nil || n < flushBatch {
+			return total, err
+		}
+	}
+}
+
+// chain переносит до limit записей очереди в журнал одной транзакцией, продолжая цепочку хешей.
+func (s *Service) chain(ctx context.Context, limit uint64) (int, error) {
+	var n int
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		prev, err := s.repository.LastHash(ctx)
+		if err != nil {
+			return err
+		}
+		queued, err := s.repository.Queued(ctx, limit)
+		if err != nil {
+			return err
+		}
+		ids := make([]int64, 0, len(queued))
+		for _, rec := range queued {
+			queueID := rec.ID
+			ids = append(ids, queueID)
+			rec.PrevHash = prev
+			if rec.Hash, err = hash(rec); err != nil {
+				return err
+			}
+			if rec.ID, err = s.repository.Insert(ctx, rec, queueID); err != nil {
+				return err
+			}
+			prev = rec.Hash
+		}
+		if len(ids) > 0 {
+			if err := s.repository.Dequeue(ctx, ids); err != nil {
+				return err
+			}
+		}
+		n = len(ids)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// StartChainWriter по тикеру встраивает очередь в цепочку, см. Flush.
+func (s *Service) StartChainWriter(ctx context.Context, handlePeriod time.Duration) {
+	const op = "Audit.Service.StartChainWriter"
+
+	log := s.log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping audit chain writer")
+				return
+
+			case <-ticker.C:
+				n, err := s.Flush(ctx)
+				if err != nil {
+					log.Error("failed to chain audit records", slog.String("error", err.Error()))
+					continue
+				}
+				if n > 0 {
+					log.Debug("audit records chained", slog.Int("count", n))
+				}
+			}
+		}
+	}()
+}
+
+// List возвращает записи журнала по фильтрам запроса, новые первыми.
+func (s *Service) List(ctx context.Context, req ListRequest) ([]*Record, error) {
+	f := Filter{Action: strings.TrimSpace(req.Action), BeforeID: req.BeforeID, Limit: req.Limit}
+	if f.Limit == 0 {
+		f.Limit = defaultListLimit
+	}
+	if req.ActorID != "" {
+		id, err := uuid.Parse(req.ActorID)
+		if err != nil {
+			return nil, utils.ErrorAuditFilterInvalid
+		}
+		f.ActorID = &id
+	}
+	if req.TargetID != "" {
+		id, err := uuid.Parse(req.TargetID)
+		if err != nil {
+			return nil, utils.ErrorAuditFilterInvalid
+		}
+		f.TargetID = &id
+	}
+	var err error
+	if f.From, err = parseTime(req.From); err != nil {
+		return nil, err
+	}
+	if f.To, err = parseTime(req.To); err != nil {
+		return nil, err
+	}
+	if f.From != nil && f.To != nil && !f.To.After(*f.From) {
+		return nil, utils.ErrorAuditFilterInvalid
+	}
+	return s.repository.List(ctx, f)
+}
+
+// Verify проходит цепочку от первой записи и пересчитывает хеши. Обход останавливается
+// на первой записи, которая не сходится: всё после неё считается недостоверным.
+func (s *Service) Verify(ctx context.Context) (*Verification, error) {
+	result := &Verification{}
+	prev := []byte{}
+	err := s.repository.Walk(ctx, func(rec *Record) error {
+		if result.BrokenAt != 0 {
+			return nil
+		}
+		result.Checked++
+		expected, err := hash(rec)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(rec.PrevHash, prev) || !bytes.Equal(rec.Hash, expected) {
+			result.BrokenAt = rec.ID
+			return nil
+		}
+		prev = rec.Hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !result.OK() {
+		s.log.Error("audit chain is broken", slog.Int64("record_id", result.BrokenAt))
+	}
+	return result, nil
+}
+
+// hash — sha256 от хеша предыдущей записи и содержимого записи без id: id выдаёт
+// последовательность, и её пропуски после откатов не должны ломать цепочку.
+func hash(rec *Record) ([]byte, error) {
+	before, err := canonical(rec.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := canonical(rec.After)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(struct {
+		Action    Action          `json:"action"`
+		ActorID   *uuid.UUID      `json:"actor_id"`
+		Actor     string          `json:"actor"`
+		TargetID  *uuid.UUID      `json:"target_id"`
+		RequestID string          `json:"request_id"`
+		IP        string          `json:"ip"`
+		Before    json.RawMessage `json:"before"`
+		After     json.RawMessage `json:"after"`
+		CreatedAt int64           `json:"created_at"`
+	}{rec.Action, rec.ActorID, rec.Actor, rec.TargetID, rec.RequestID, rec.IP, before, after, rec.CreatedAt.UnixMicro()})
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.New()
+	sum.Write(rec.PrevHash)
+	sum.Write(body)
+	return sum.Sum(nil), nil
+}
+
+func marshal(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// canonical приводит JSON к одному виду: JSONB переупорядочивает ключи, убирает пробелы
+// и пишет числа без экспоненты, поэтому хеш считается не от исходных байтов, а от повторной
+// сериализации с сортировкой ключей и числами через float64.
+func canonical(raw json.RawMessage) (json.RawMessage, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, utils.ErrorAuditFilterInvalid
+	}
+	t = t.UTC()
+	return &t, nil
+}
@@ -0,0 +1,152 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// fakeTx — транзакция в контексте: репозиторий поддельный, её методы не вызываются.
+type fakeTx struct{ pgx.Tx }
+
+// fakeJournal хранит очередь и журнал в памяти. Before и After он, как JSONB, хранит
+// переформатированными, а id выдаёт с пропусками, как последовательность после откатов.
+type fakeJournal struct {
+	queue   []*Record
+	log     []*Record
+	queueID int64
+	logID   int64
+}
+
+func (j *fakeJournal) Enqueue(_ context.Context, rec *Record) error {
+	j.queueID++
+	copied := *rec
+	copied.ID = j.queueID
+	j.queue = append(j.queue, &copied)
+	return nil
+}
+
+func (j *fakeJournal) Queued(_ context.Context, limit uint64) ([]*Record, error) {
+	n := min(int(limit), len(j.queue))
+	return append([]*Record(nil), j.queue[:n]...), nil
+}
+
+func (j *fakeJournal) Dequeue(_ context.Context, ids []int64) error {
+	j.queue = j.queue[len(ids):]
+	return nil
+}
+
+func (j *fakeJournal) LastHash(context.Context) ([]byte, error) {
+	if len(j.log) == 0 {
+		return []byte{}, nil
+	}
+	return j.log[len(j.log)-1].Hash, nil
+}
+
+func (j *fakeJournal) Insert(_ context.Context, rec *Record, _ int64) (int64, error) {
+	j.logID += 3
+	copied := *rec
+	copied.ID = j.logID
+	copied.Before, copied.After = jsonb(rec.Before), jsonb(rec.After)
+	j.log = append(j.log, &copied)
+	return copied.ID, nil
+}
+
+func (j *fakeJournal) List(context.Context, Filter) ([]*Record, error) { return nil, nil }
+
+func (j *fakeJournal) Walk(_ context.Context, fn func(*Record) error) error {
+	for _, rec := range j.log {
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonb переформатирует JSON так, как его вернула бы колонка JSONB: с другими пробелами.
+func jsonb(raw json.RawMessage) json.RawMessage {
+	if raw == nil {
+		return nil
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, "", "  "); err != nil {
+		panic(err)
+	}
+	return out.Bytes()
+}
+
+// TestVerify — цепочка сходится после переноса очереди в журнал и ломается на первой записи,
+// которую изменили, удалили или пересчитали без остальной цепочки.
+func TestVerify(t *testing.T) {
+	cases := []struct {
+		name         string
+		tamper       func(j *fakeJournal)
+		wantBrokenAt func(j *fakeJournal) int64
+		wantChecked  int64
+	}{
+		{"intact", func(*fakeJournal) {}, func(*fakeJournal) int64 { return 0 }, 4},
+		{"changed after", func(j *fakeJournal) {
+			j.log[1].After = json.RawMessage(`{"USD": 1000000}`)
+		}, func(j *fakeJournal) int64 { return j.log[1].ID }, 2},
+		{"changed actor", func(j *fakeJournal) {
+			j.log[2].Actor = "operator"
+		}, func(j *fakeJournal) int64 { return j.log[2].ID }, 3},
+		{"rehashed record", func(j *fakeJournal) {
+			rec := j.log[1]
+			rec.Actor = "operator"
+			rec.Hash, _ = hash(rec)
+		}, func(j *fakeJournal) int64 { return j.log[2].ID }, 3},
+		{"deleted record", func(j *fakeJournal) {
+			j.log = append(j.log[:1], j.log[2:]...)
+		}, func(j *fakeJournal) int64 { return j.log[1].ID }, 2},
+		{"empty journal", func(j *fakeJournal) {
+			j.log = nil
+		}, func(*fakeJournal) int64 { return 0 }, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			j := &fakeJournal{}
+			s := NewService(j, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			ctx := db.WithTx(context.Background(), fakeTx{})
+			userID := uuid.New()
+			entries := []Entry{
+				{Action: ActionRegister, ActorID: &userID, TargetID: &userID},
+				{Action: ActionDeposit, ActorID: &userID, TargetID: &userID,
+					Before: map[string]float32{"USD": 0}, After: map[string]float32{"USD": 10.5}},
+				{Action: ActionAdjustment, Actor: "alice", TargetID: &userID,
+					Before: map[string]float32{"USD": 10.5}, After: map[string]float32{"USD": 12}},
+				{Action: ActionHoldExpire, TargetID: &userID},
+			}
+			for _, e := range entries {
+				if err := s.Record(ctx, e); err != nil {
+					t.Fatalf("record %s: %v", e.Action, err)
+				}
+			}
+			// несколько проходов: цепочка продолжается с хеша последней записи прошлого прохода
+			for _, limit := range []uint64{1, 2, 10} {
+				if _, err := s.chain(ctx, limit); err != nil {
+					t.Fatalf("chain: %v", err)
+				}
+			}
+			if len(j.queue) != 0 || len(j.log) != len(entries) {
+				t.Fatalf("queue %d, journal %d; want everything chained", len(j.queue), len(j.log))
+			}
+
+			c.tamper(j)
+			v, err := s.Verify(context.Background())
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if want := c.wantBrokenAt(j); v.BrokenAt != want || v.Checked != c.wantChecked {
+				t.Errorf("Verify = %+v, want broken at %d after %d checked", v, want, c.wantChecked)
+			}
+		})
+	}
+}
@@ -11,3 +11,25 @@ type EventDB struct {
 	ReservedTo time.Time `db:"reserved_to"`
 	Payload    string    `db:"payload"`
 }
+
+// Статусы событий outbox: new ждёт отправки воркером, done уже отправлено.
+const (
+	StatusNew       = "new"
+	StatusProcessed = "processed"
+	StatusDone      = "done"
+)
+
+// OutboxEvent — событие outbox со статусом для операторских инструментов.
+type OutboxEvent struct {
+	EventDB
+	Status    string    `db:"status"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Selector выбирает события по списку id или по статусу. Limit ограничивает выборку по статусу.
+type Selector struct {
+	IDs    []uuid.UUID
+	Status string
+	Limit  uint64
+}
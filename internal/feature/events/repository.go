@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
 	"github.com/Sanchir01/currency-wallet/pkg/utils"
 
 	"github.com/google/uuid"
@@ -23,7 +24,8 @@ func NewRepository(primaryDB *pgxpool.Pool) *Repository {
 	}
 }
 
-func (r *Repository) CreateEvent(ctx context.Context, eventType, payload string, tx pgx.Tx) (uuid.UUID, error) {
+func (r *Repository) CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error) {
+	conn := db.Conn(ctx, r.primaryDB)
 	thistime := time.Now()
 	reservedtime := thistime.Add(1 * time.Hour)
 	query, args, err := sq.Insert("events").
@@ -36,18 +38,14 @@ func (r *Repository) CreateEvent(ctx context.Context, eventType, payload string,
 		return uuid.Nil, err
 	}
 	var id uuid.UUID
-	if err := tx.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+	if err := conn.QueryRow(ctx, query, args...).Scan(&id); err != nil {
 		return uuid.Nil, err
 	}
 	return id, nil
 }
 
 func (r *Repository) GetManyEvents(ctx context.Context, limit uint64) ([]*EventDB, error) {
-	conn, err := r.primaryDB.Acquire(ctx)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Release()
+	conn := db.Conn(ctx, r.primaryDB)
 	query, args, err := sq.
 		Select("id,event_type,payload,reserved_to").
 		From("events").
@@ -85,11 +83,7 @@ func (r *Repository) GetManyEvents(ctx context.Context, limit uint64) ([]*EventD
 }
 
 func (r *Repository) SetDone(ctx context.Context, ids []uuid.UUID) error {
-	conn, err := r.primaryDB.Acquire(ctx)
-	if err != nil {
-		return err
-	}
-	defer conn.Release()
+	conn := db.Conn(ctx, r.primaryDB)
 
 	query, args, err := sq.Update("events").
 		Set("status", "done").
@@ -100,10 +94,74 @@ func (r *Repository) SetDone(ctx context.Context, ids []uuid.UUID) error {
 	if err != nil {
 		return utils.ErrorQueryString
 	}
-	slog.Error("ids events", ids)
+	slog.Debug("ids events", slog.Any("ids", ids))
 	if _, err := conn.Exec(ctx, query, args...); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (sel Selector) where(builder sq.SelectBuilder) sq.SelectBuilder {
+	if len(sel.IDs) > 0 {
+		builder = builder.Where(sq.Eq{"id": sel.IDs})
+	}
+	if sel.Status != "" {
+		builder = builder.Where(sq.Eq{"status": sel.Status})
+	}
+	if sel.Limit > 0 {
+		builder = builder.Limit(sel.Limit)
+	}
+	return builder
+}
+
+// FindEvents возвращает события по id или статусу, старые первыми.
+func (r *Repository) FindEvents(ctx context.Context, sel Selector) ([]*OutboxEvent, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sel.where(sq.
+		Select("id, event_type, payload, reserved_to, status, created_at, updated_at").
+		From("events").
+		OrderBy("created_at", "id")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	events := make([]*OutboxEvent, 0)
+	for rows.Next() {
+		var e OutboxEvent
+		if err := rows.Scan(&e.ID, &e.Type, &e.Payload, &e.ReservedTo, &e.Status, &e.CreatedAt, &e.UpdatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Requeue возвращает события в статус new, воркер outbox отправит их заново.
+func (r *Repository) Requeue(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Update("events").
+		Set("status", StatusNew).
+		Set("reserved_to", sq.Expr("CURRENT_TIMESTAMP + INTERVAL '1 hour'")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -5,13 +5,12 @@ import (
 	"encoding/json"
 	"github.com/Sanchir01/currency-wallet/pkg/logger"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"log/slog"
 	"time"
 )
 
 type EventRepositoryInterface interface {
-	CreateEvent(ctx context.Context, eventType, payload string, tx pgx.Tx) (uuid.UUID, error)
+	CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error)
 	GetManyEvents(ctx context.Context, limit uint64) ([]*EventDB, error)
 	SetDone(ctx context.Context, ids []uuid.UUID) error
 }
@@ -0,0 +1,121 @@
+package order
+
+import (
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	StatusOpen      Status = "OPEN"
+	StatusExecuted  Status = "EXECUTED"
+	StatusCancelled Status = "CANCELLED"
+	StatusExpired   Status = "EXPIRED"
+)
+
+// Типы событий outbox для каждого перехода состояния ордера.
+const (
+	EventTypePlaced    = "LIMIT_ORDER_PLACED"
+	EventTypeExecuted  = "LIMIT_ORDER_EXECUTED"
+	EventTypeCancelled = "LIMIT_ORDER_CANCELLED"
+	EventTypeExpired   = "LIMIT_ORDER_EXPIRED"
+)
+
+// Коды уведомлений в каталоге i18n.
+const (
+	NotificationPlaced    = "notification.limit_order_placed"
+	NotificationExecuted  = "notification.limit_order_executed"
+	NotificationCancelled = "notification.limit_order_cancelled"
+	NotificationExpired   = "notification.limit_order_expired"
+)
+
+type LimitOrderDB struct {
+	ID             uuid.UUID  `db:"id"`
+	UserID         uuid.UUID  `db:"user_id"`
+	WalletID       uuid.UUID  `db:"wallet_id"`
+	FromCurrency   string     `db:"from_currency"`
+	ToCurrency     string     `db:"to_currency"`
+	Amount         float32    `db:"amount"`
+	TargetRate     float32    `db:"target_rate"`
+	Status         Status     `db:"status"`
+	ExpiresAt      time.Time  `db:"expires_at"`
+	ExecutedRate   *float32   `db:"executed_rate"`
+	ExecutedAmount *float32   `db:"executed_amount"`
+	ClosedAt       *time.Time `db:"closed_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// Pair — валютная пара, по которой есть открытые ордера.
+type Pair struct {
+	FromCurrency string
+	ToCurrency   string
+}
+
+// PlaceOrderRequest: обменять amount из from_currency в to_currency, когда курс
+// (сколько to_currency дают за единицу from_currency) станет не ниже target_rate.
+type PlaceOrderRequest struct {
+	FromCurrency string    `json:"from_currency" validate:"required,currency"`
+	ToCurrency   string    `json:"to_currency" validate:"required,currency,nefield=FromCurrency"`
+	Amount       float32   `json:"amount" validate:"required,money"`
+	TargetRate   float32   `json:"target_rate" validate:"required,positive"`
+	ExpiresAt    time.Time `json:"expires_at" validate:"required"`
+	TOTPCode     string    `json:"totp_code,omitempty"`
+}
+
+type LimitOrder struct {
+	ID             uuid.UUID  `json:"id"`
+	FromCurrency   string     `json:"from_currency"`
+	ToCurrency     string     `json:"to_currency"`
+	Amount         float32    `json:"amount"`
+	TargetRate     float32    `json:"target_rate"`
+	Status         Status     `json:"status"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ExecutedRate   *float32   `json:"executed_rate,omitempty"`
+	ExecutedAmount *float32   `json:"executed_amount,omitempty"`
+	ClosedAt       *time.Time `json:"closed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func NewLimitOrder(o *LimitOrderDB) LimitOrder {
+	return LimitOrder{
+		ID:             o.ID,
+		FromCurrency:   o.FromCurrency,
+		ToCurrency:     o.ToCurrency,
+		Amount:         o.Amount,
+		TargetRate:     o.TargetRate,
+		Status:         o.Status,
+		ExpiresAt:      o.ExpiresAt,
+		ExecutedRate:   o.ExecutedRate,
+		ExecutedAmount: o.ExecutedAmount,
+		ClosedAt:       o.ClosedAt,
+		CreatedAt:      o.CreatedAt,
+	}
+}
+
+type LimitOrderResponse struct {
+	api.Response
+	Order LimitOrder `json:"order"`
+}
+
+type LimitOrdersResponse struct {
+	api.Response
+	Orders []LimitOrder `json:"orders"`
+}
+
+type KafkaPayloadOrder struct {
+	OrderID        uuid.UUID `json:"order_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Status         Status    `json:"status"`
+	FromCurrency   string    `json:"from_currency"`
+	ToCurrency     string    `json:"to_currency"`
+	Amount         float32   `json:"amount"`
+	TargetRate     float32   `json:"target_rate"`
+	ExecutedRate   *float32  `json:"executed_rate,omitempty"`
+	ExecutedAmount *float32  `json:"executed_amount,omitempty"`
+	Code           string    `json:"code"`
+	Locale         string    `json:"locale"`
+	Message        string    `json:"message"`
+}
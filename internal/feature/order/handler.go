@@ -0,0 +1,177 @@
+package order
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	httphandlers "github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+)
+
+type HandlerOrders interface {
+	PlaceOrder(ctx context.Context, userID uuid.UUID, req PlaceOrderRequest) (*LimitOrderDB, error)
+	ListOrders(ctx context.Context, userID uuid.UUID, status *Status) ([]*LimitOrderDB, error)
+	CancelOrder(ctx context.Context, userID, id uuid.UUID) (*LimitOrderDB, error)
+}
+
+type Handler struct {
+	s   HandlerOrders
+	log *slog.Logger
+}
+
+func NewHandler(s HandlerOrders, log *slog.Logger) *Handler {
+	return &Handler{
+		s:   s,
+		log: log,
+	}
+}
+
+// writeError отвечает доменной ошибкой, в лог пишутся только непредвиденные ошибки.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	if utils.AsError(err).Kind == utils.KindInternal {
+		log.Error("request failed", logger.Err(err))
+	}
+	api.WriteError(w, r, err)
+}
+
+// @Summary PlaceLimitOrder
+// @ID placeLimitOrder
+// @Tags orders
+// @Description exchange amount of from_currency to to_currency once the rate (to_currency per one from_currency)
+// @Description reaches target_rate. The amount is reserved from the source wallet until the order is executed,
+// @Description cancelled or expires. Large amounts require totp_code
+// @Accept json
+// @Produce json
+// @Param input body PlaceOrderRequest true "limit order"
+// @Success 201 {object}  LimitOrderResponse
+// @Failure 400,401,403,404,413,422 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /exchange/orders [post]
+func (h *Handler) PlaceOrderHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Order.Handler.PlaceOrder"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req PlaceOrderRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	o, err := h.s.PlaceOrder(r.Context(), claims.ID, req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, LimitOrderResponse{
+		Response: api.OK(),
+		Order:    NewLimitOrder(o),
+	})
+}
+
+// @Summary ListLimitOrders
+// @ID listLimitOrders
+// @Tags orders
+// @Description limit orders of the current user, newest first
+// @Produce json
+// @Param status query string false "filter by status" Enums(OPEN, EXECUTED, CANCELLED, EXPIRED)
+// @Success 200 {object}  LimitOrdersResponse
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /exchange/orders [get]
+func (h *Handler) ListOrdersHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Order.Handler.ListOrders"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var status *Status
+	if v := r.URL.Query().Get("status"); v != "" {
+		st := Status(v)
+		switch st {
+		case StatusOpen, StatusExecuted, StatusCancelled, StatusExpired:
+			status = &st
+		default:
+			api.WriteError(w, r, &api.ValidationError{Fields: []api.FieldError{{
+				Field: "status", Rule: "oneof", Param: "OPEN EXECUTED CANCELLED EXPIRED",
+			}}})
+			return
+		}
+	}
+	orders, err := h.s.ListOrders(r.Context(), claims.ID, status)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	resp := LimitOrdersResponse{
+		Response: api.OK(),
+		Orders:   make([]LimitOrder, 0, len(orders)),
+	}
+	for _, o := range orders {
+		resp.Orders = append(resp.Orders, NewLimitOrder(o))
+	}
+	render.JSON(w, r, resp)
+}
+
+// @Summary CancelLimitOrder
+// @ID cancelLimitOrder
+// @Tags orders
+// @Description cancel an open limit order, the reserved amount is returned to the source wallet
+// @Produce json
+// @Param id path string true "order id"
+// @Success 200 {object}  LimitOrderResponse
+// @Failure 400,401,404,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /exchange/orders/{id} [delete]
+func (h *Handler) CancelOrderHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Order.Handler.CancelOrder"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidOrderID)
+		return
+	}
+	o, err := h.s.CancelOrder(r.Context(), claims.ID, id)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, LimitOrderResponse{
+		Response: api.OK(),
+		Order:    NewLimitOrder(o),
+	})
+}
@@ -0,0 +1,244 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+const orderColumns = "id, user_id, wallet_id, from_currency, to_currency, amount, target_rate, status, expires_at, executed_rate, executed_amount, closed_at, created_at"
+
+func scanOrder(row pgx.Row) (*LimitOrderDB, error) {
+	var o LimitOrderDB
+	if err := row.Scan(&o.ID, &o.UserID, &o.WalletID, &o.FromCurrency, &o.ToCurrency, &o.Amount, &o.TargetRate, &o.Status,
+		&o.ExpiresAt, &o.ExecutedRate, &o.ExecutedAmount, &o.ClosedAt, &o.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+func scanOrders(rows pgx.Rows) ([]*LimitOrderDB, error) {
+	defer rows.Close()
+	orders := make([]*LimitOrderDB, 0)
+	for rows.Next() {
+		o, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *Repository) CreateOrder(ctx context.Context, o *LimitOrderDB) (*LimitOrderDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Insert("limit_orders").
+		Columns("user_id", "wallet_id", "from_currency", "to_currency", "amount", "target_rate", "expires_at").
+		Values(o.UserID, o.WalletID, o.FromCurrency, o.ToCurrency, o.Amount, o.TargetRate, o.ExpiresAt).
+		Suffix("RETURNING " + orderColumns).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	return scanOrder(conn.QueryRow(ctx, query, arg...))
+}
+
+func (r *Repository) ListOrders(ctx context.Context, userID uuid.UUID, status *Status) ([]*LimitOrderDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	builder := sq.
+		Select(orderColumns).
+		From("limit_orders").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(sq.Dollar)
+	if status != nil {
+		builder = builder.Where(sq.Eq{"status": *status})
+	}
+	query, arg, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	return scanOrders(rows)
+}
+
+// LockOrder блокирует ордер пользователя до конца транзакции.
+func (r *Repository) LockOrder(ctx context.Context, id, userID uuid.UUID) (*LimitOrderDB, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, arg, err := sq.
+		Select(orderColumns).
+		From("limit_orders").
+		Where(sq.Eq{"id": id, "user_id": userID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	o, err := scanOrder(tx.QueryRow(ctx, query, arg...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorOrderNotFound
+		}
+		return nil, err
+	}
+	return o, nil
+}
+
+// OpenPairs возвращает валютные пары, курс которых нужно проверить.
+func (r *Repository) OpenPairs(ctx context.Context) ([]Pair, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select("DISTINCT from_currency, to_currency").
+		From("limit_orders").
+		Where(sq.Eq{"status": StatusOpen}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	pairs := make([]Pair, 0)
+	for rows.Next() {
+		var p Pair
+		if err := rows.Scan(&p.FromCurrency, &p.ToCurrency); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// ListExecutable возвращает открытые ордера пары, условие которых выполнено при курсе rate.
+// Ордера не блокируются: каждый исполняется в своей транзакции после LockOpenOrder.
+func (r *Repository) ListExecutable(ctx context.Context, pair Pair, rate float32, now time.Time, limit uint64) ([]*LimitOrderDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Select(orderColumns).
+		From("limit_orders").
+		Where(sq.Eq{"status": StatusOpen, "from_currency": pair.FromCurrency, "to_currency": pair.ToCurrency}).
+		Where(sq.LtOrEq{"target_rate": rate}).
+		Where(sq.Gt{"expires_at": now}).
+		OrderBy("created_at").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	return scanOrders(rows)
+}
+
+func (r *Repository) ListExpired(ctx context.Context, now time.Time, limit uint64) ([]*LimitOrderDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Select(orderColumns).
+		From("limit_orders").
+		Where(sq.Eq{"status": StatusOpen}).
+		Where(sq.LtOrEq{"expires_at": now}).
+		OrderBy("expires_at").
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	return scanOrders(rows)
+}
+
+// LockOpenOrder блокирует открытый ордер до конца транзакции. SKIP LOCKED позволяет нескольким
+// экземплярам воркера не мешать друг другу: ордер, занятый другим экземпляром или уже закрытый,
+// возвращается как utils.ErrorOrderNotFound.
+func (r *Repository) LockOpenOrder(ctx context.Context, id uuid.UUID) (*LimitOrderDB, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, arg, err := sq.
+		Select(orderColumns).
+		From("limit_orders").
+		Where(sq.Eq{"id": id, "status": StatusOpen}).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	o, err := scanOrder(tx.QueryRow(ctx, query, arg...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorOrderNotFound
+		}
+		return nil, err
+	}
+	return o, nil
+}
+
+// CloseOrder переводит открытый ордер в конечное состояние.
+func (r *Repository) CloseOrder(ctx context.Context, o *LimitOrderDB) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("limit_orders").
+		Set("status", o.Status).
+		Set("executed_rate", o.ExecutedRate).
+		Set("executed_amount", o.ExecutedAmount).
+		Set("closed_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": o.ID, "status": StatusOpen}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorOrderClosed
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type ServiceOrders interface {
+	CreateOrder(ctx context.Context, o *LimitOrderDB) (*LimitOrderDB, error)
+	ListOrders(ctx context.Context, userID uuid.UUID, status *Status) ([]*LimitOrderDB, error)
+	LockOrder(ctx context.Context, id, userID uuid.UUID) (*LimitOrderDB, error)
+	OpenPairs(ctx context.Context) ([]Pair, error)
+	ListExecutable(ctx context.Context, pair Pair, rate float32, now time.Time, limit uint64) ([]*LimitOrderDB, error)
+	ListExpired(ctx context.Context, now time.Time, limit uint64) ([]*LimitOrderDB, error)
+	LockOpenOrder(ctx context.Context, id uuid.UUID) (*LimitOrderDB, error)
+	CloseOrder(ctx context.Context, o *LimitOrderDB) error
+}
+
+type ServiceWallets interface {
+	PlaceHold(ctx context.Context, userID uuid.UUID, currency string, amount float32) (uuid.UUID, error)
+	ReleaseHold(ctx context.Context, walletID uuid.UUID, held, captured float32) error
+}
+
+type ServiceRates interface {
+	GetExchangeRateForCurrency(ctx context.Context, to_currency, from_currency string) (*wallet.ExchangeRateToCurrency, error)
+}
+
+type ServiceExchanges interface {
+	ExchangeHeld(ctx context.Context, userid, walletID uuid.UUID, rate *wallet.ExchangeRateToCurrency, to_currency_amount, from_currency_amount float32, idempotencyKey string) (*models.CurrencyWalletDB, error)
+}
+
+type ServiceUsers interface {
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	RequireStepUp(ctx context.Context, userID uuid.UUID, amount float32, currency, code string) error
+	NotificationLocale(ctx context.Context, userID uuid.UUID) (i18n.Locale, error)
+}
+
+type ServiceEvents interface {
+	CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error)
+}
+
+type ServiceAudit interface {
+	Record(ctx context.Context, e audit.Entry) error
+}
+
+type Service struct {
+	repository ServiceOrders
+	wallets    ServiceWallets
+	rates      ServiceRates
+	exchanges  ServiceExchanges
+	users      ServiceUsers
+	events     ServiceEvents
+	audit      ServiceAudit
+	tx         *db.TxManager
+	maxTTL     time.Duration
+	log        *slog.Logger
+}
+
+func NewService(r ServiceOrders, wallets ServiceWallets, rates ServiceRates, exchanges ServiceExchanges, users ServiceUsers, events ServiceEvents, audit ServiceAudit, tx *db.TxManager, maxTTL time.Duration, log *slog.Logger) *Service {
+	return &Service{
+		repository: r,
+		wallets:    wallets,
+		rates:      rates,
+		exchanges:  exchanges,
+		users:      users,
+		events:     events,
+		audit:      audit,
+		tx:         tx,
+		maxTTL:     maxTTL,
+		log:        log,
+	}
+}
+
+// PlaceOrder создаёт ордер и сразу резервирует сумму в held исходного кошелька, как холд:
+// баланс её по-прежнему показывает, но до исполнения, отмены или истечения срока она недоступна.
+func (s *Service) PlaceOrder(ctx context.Context, userID uuid.UUID, req PlaceOrderRequest) (*LimitOrderDB, error) {
+	const op = "Order.Service.PlaceOrder"
+	log := s.log.With(slog.String("op", op))
+
+	now := time.Now().UTC()
+	expiresAt := req.ExpiresAt.UTC()
+	if !expiresAt.After(now) || expiresAt.After(now.Add(s.maxTTL)) {
+		return nil, utils.ErrorOrderExpiryInvalid
+	}
+	verified, err := s.users.IsEmailVerified(ctx, userID)
+	if err != nil {
+		log.Error("failed to check email verification", logger.Err(err))
+		return nil, err
+	}
+	if !verified {
+		return nil, utils.ErrorEmailNotVerified
+	}
+	if err := s.users.RequireStepUp(ctx, userID, req.Amount, req.FromCurrency, req.TOTPCode); err != nil {
+		return nil, err
+	}
+
+	var placed *LimitOrderDB
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		walletID, err := s.wallets.PlaceHold(ctx, userID, req.FromCurrency, req.Amount)
+		if err != nil {
+			return err
+		}
+		o, err := s.repository.CreateOrder(ctx, &LimitOrderDB{
+			UserID:       userID,
+			WalletID:     walletID,
+			FromCurrency: req.FromCurrency,
+			ToCurrency:   req.ToCurrency,
+			Amount:       req.Amount,
+			TargetRate:   req.TargetRate,
+			ExpiresAt:    expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		placed = o
+		return s.emit(ctx, o, EventTypePlaced, NotificationPlaced)
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to place order", logger.Err(err))
+		}
+		return nil, err
+	}
+	return placed, nil
+}
+
+func (s *Service) ListOrders(ctx context.Context, userID uuid.UUID, status *Status) ([]*LimitOrderDB, error) {
+	return s.repository.ListOrders(ctx, userID, status)
+}
+
+// CancelOrder закрывает открытый ордер и снимает резерв.
+func (s *Service) CancelOrder(ctx context.Context, userID, id uuid.UUID) (*LimitOrderDB, error) {
+	const op = "Order.Service.CancelOrder"
+	log := s.log.With(slog.String("op", op))
+
+	var cancelled *LimitOrderDB
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		o, err := s.repository.LockOrder(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		if o.Status != StatusOpen {
+			return utils.ErrorOrderClosed
+		}
+		if err := s.release(ctx, o, StatusCancelled); err != nil {
+			return err
+		}
+		cancelled = o
+		return s.emit(ctx, o, EventTypeCancelled, NotificationCancelled)
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to cancel order", logger.Err(err))
+		}
+		return nil, err
+	}
+	return cancelled, nil
+}
+
+// StartWatcher по тикеру закрывает просроченные ордера и исполняет ордера,
+// условие которых выполнено по текущему курсу из кеша.
+func (s *Service) StartWatcher(ctx context.Context, handlePeriod time.Duration, limit uint64) {
+	const op = "Order.Service.StartWatcher"
+
+	log := s.log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping limit order watcher")
+				return
+
+			case <-ticker.C:
+				if err := s.expireOrders(ctx, limit); err != nil {
+					log.Error("failed to expire orders", logger.Err(err))
+				}
+				pairs, err := s.repository.OpenPairs(ctx)
+				if err != nil {
+					log.Error("failed to get open pairs", logger.Err(err))
+					continue
+				}
+				for _, pair := range pairs {
+					rate, err := s.rates.GetExchangeRateForCurrency(ctx, pair.ToCurrency, pair.FromCurrency)
+					if err != nil {
+						log.Warn("rate is unavailable", slog.String("from", pair.FromCurrency), slog.String("to", pair.ToCurrency), logger.Err(err))
+						continue
+					}
+					if err := s.executeOrders(ctx, pair, rate, limit); err != nil {
+						log.Error("failed to execute orders", slog.String("from", pair.FromCurrency), slog.String("to", pair.ToCurrency), logger.Err(err))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// executeOrders исполняет ордера пары по курсу rate. Каждый ордер исполняется в своей транзакции:
+// ошибка одного ордера записывается в лог и не откатывает остальные.
+func (s *Service) executeOrders(ctx context.Context, pair Pair, snapshot *wallet.ExchangeRateToCurrency, limit uint64) error {
+	const op = "Order.Service.executeOrders"
+	log := s.log.With(slog.String("op", op))
+
+	orders, err := s.repository.ListExecutable(ctx, pair, snapshot.Rate, time.Now().UTC(), limit)
+	if err != nil {
+		return err
+	}
+	var executed int
+	for _, o := range orders {
+		ok, err := s.executeOrder(ctx, o.ID, snapshot)
+		if err != nil {
+			log.Error("failed to execute order", slog.String("order_id", o.ID.String()), logger.Err(err))
+			continue
+		}
+		if ok {
+			executed++
+		}
+	}
+	if executed > 0 {
+		log.Info("limit orders executed", slog.Int("count", executed), slog.Any("rate", snapshot.Rate))
+	}
+	return nil
+}
+
+// executeOrder исполняет ордер id по снимку курса. Зарезервированная сумма списывается из held и обменивается
+// так же, как обычный обмен кошелька: обе ноги ссылаются на снимок курса, пользователь получает уведомление об обмене.
+// Ордер, который уже закрыт или обрабатывается другим экземпляром воркера, пропускается.
+func (s *Service) executeOrder(ctx context.Context, id uuid.UUID, snapshot *wallet.ExchangeRateToCurrency) (executed bool, err error) {
+	rate := snapshot.Rate
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		executed = false
+		o, err := s.repository.LockOpenOrder(ctx, id)
+		if err != nil {
+			if errors.Is(err, utils.ErrorOrderNotFound) {
+				return nil
+			}
+			return err
+		}
+		if rate < o.TargetRate || !o.ExpiresAt.After(time.Now().UTC()) {
+			return nil
+		}
+		executedAmount := o.Amount * rate
+		o.Status = StatusExecuted
+		o.ExecutedRate = &rate
+		o.ExecutedAmount = &executedAmount
+		if err := s.repository.CloseOrder(ctx, o); err != nil {
+			return err
+		}
+		data, err := s.exchanges.ExchangeHeld(ctx, o.UserID, o.WalletID, snapshot, executedAmount, o.Amount, "limit_order:"+o.ID.String()+":execute")
+		if err != nil {
+			return err
+		}
+		if err := s.emit(ctx, o, EventTypeExecuted, NotificationExecuted); err != nil {
+			return err
+		}
+		if err := s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionOrderExecute,
+			TargetID: &o.UserID,
+			Before: map[string]float32{
+				o.FromCurrency: data.Balances[o.FromCurrency] + o.Amount,
+				o.ToCurrency:   data.Balances[o.ToCurrency] - executedAmount,
+			},
+			After: map[string]float32{
+				o.FromCurrency: data.Balances[o.FromCurrency],
+				o.ToCurrency:   data.Balances[o.ToCurrency],
+			},
+		}); err != nil {
+			return err
+		}
+		executed = true
+		return nil
+	})
+	return executed, err
+}
+
+// expireOrders закрывает просроченные ордера, каждый в своей транзакции.
+func (s *Service) expireOrders(ctx context.Context, limit uint64) error {
+	const op = "Order.Service.expireOrders"
+	log := s.log.With(slog.String("op", op))
+
+	orders, err := s.repository.ListExpired(ctx, time.Now().UTC(), limit)
+	if err != nil {
+		return err
+	}
+	for _, o := range orders {
+		if err := s.expireOrder(ctx, o.ID); err != nil {
+			log.Error("failed to expire order", slog.String("order_id", o.ID.String()), logger.Err(err))
+		}
+	}
+	return nil
+}
+
+func (s *Service) expireOrder(ctx context.Context, id uuid.UUID) error {
+	return s.tx.Do(ctx, func(ctx context.Context) error {
+		o, err := s.repository.LockOpenOrder(ctx, id)
+		if err != nil {
+			if errors.Is(err, utils.ErrorOrderNotFound) {
+				return nil
+			}
+			return err
+		}
+		if err := s.release(ctx, o, StatusExpired); err != nil {
+			return err
+		}
+		return s.emit(ctx, o, EventTypeExpired, NotificationExpired)
+	})
+}
+
+// release закрывает ордер без исполнения и снимает резерв с исходного кошелька.
+func (s *Service) release(ctx context.Context, o *LimitOrderDB, status Status) error {
+	o.Status = status
+	if err := s.repository.CloseOrder(ctx, o); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	o.ClosedAt = &now
+	return s.wallets.ReleaseHold(ctx, o.WalletID, o.Amount, 0)
+}
+
+func (s *Service) emit(ctx context.Context, o *LimitOrderDB, eventType, code string) error {
+	locale, err := s.users.NotificationLocale(ctx, o.UserID)
+	if err != nil {
+		return err
+	}
+	rate := o.TargetRate
+	var executedAmount float32
+	if o.ExecutedRate != nil {
+		rate, executedAmount = *o.ExecutedRate, *o.ExecutedAmount
+	}
+	payload, err := json.Marshal(KafkaPayloadOrder{
+		OrderID:        o.ID,
+		UserID:         o.UserID,
+		Status:         o.Status,
+		FromCurrency:   o.FromCurrency,
+		ToCurrency:     o.ToCurrency,
+		Amount:         o.Amount,
+		TargetRate:     o.TargetRate,
+		ExecutedRate:   o.ExecutedRate,
+		ExecutedAmount: o.ExecutedAmount,
+		Code:           code,
+		Locale:         string(locale),
+		Message:        i18n.Message(locale, code, o.Amount, o.FromCurrency, o.ToCurrency, rate, executedAmount),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.events.CreateEvent(ctx, eventType, string(payload))
+	return err
+}
@@ -0,0 +1,337 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// fakeTx — транзакция в контексте: репозитории поддельные, её методы не вызываются.
+type fakeTx struct{ pgx.Tx }
+
+// fakeWallet — кошелёк одной валюты: balance включает held, доступно balance - held.
+type fakeWallet struct {
+	id      uuid.UUID
+	balance float32
+	held    float32
+}
+
+// fakeBook хранит ордера и кошельки одного пользователя в памяти и подменяет сразу все зависимости
+// сервиса: репозиторий ордеров, кошельки, обмен, пользователей, outbox и журнал аудита.
+type fakeBook struct {
+	userID  uuid.UUID
+	wallets map[string]*fakeWallet
+	orders  map[uuid.UUID]*LimitOrderDB
+	events  []string
+	audited []audit.Action
+}
+
+func newFakeBook(balances map[string]float32) *fakeBook {
+	b := &fakeBook{userID: uuid.New(), wallets: map[string]*fakeWallet{}, orders: map[uuid.UUID]*LimitOrderDB{}}
+	for currency, balance := range balances {
+		b.wallets[currency] = &fakeWallet{id: uuid.New(), balance: balance}
+	}
+	return b
+}
+
+func (b *fakeBook) walletByID(id uuid.UUID) *fakeWallet {
+	for _, w := range b.wallets {
+		if w.id == id {
+			return w
+		}
+	}
+	return nil
+}
+
+func (b *fakeBook) CreateOrder(_ context.Context, o *LimitOrderDB) (*LimitOrderDB, error) {
+	o.ID = uuid.New()
+	o.Status = StatusOpen
+	b.orders[o.ID] = o
+	return o, nil
+}
+
+func (b *fakeBook) ListOrders(context.Context, uuid.UUID, *Status) ([]*LimitOrderDB, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *fakeBook) LockOrder(_ context.Context, id, _ uuid.UUID) (*LimitOrderDB, error) {
+	o, ok := b.orders[id]
+	if !ok {
+		return nil, utils.ErrorOrderNotFound
+	}
+	return o, nil
+}
+
+func (b *fakeBook) OpenPairs(context.Context) ([]Pair, error) {
+	return nil, errors.New("not implemented")
+}
+
+// ListExecutable отдаёт все открытые ордера пары без фильтра по курсу и сроку: условия
+// исполнения должен перепроверить сам сервис под блокировкой ордера.
+func (b *fakeBook) ListExecutable(_ context.Context, pair Pair, _ float32, _ time.Time, _ uint64) ([]*LimitOrderDB, error) {
+	var orders []*LimitOrderDB
+	for _, o := range b.orders {
+		if o.Status == StatusOpen && o.FromCurrency == pair.FromCurrency && o.ToCurrency == pair.ToCurrency {
+			orders = append(orders, o)
+		}
+	}
+	return orders, nil
+}
+
+func (b *fakeBook) ListExpired(_ context.Context, now time.Time, _ uint64) ([]*LimitOrderDB, error) {
+	var orders []*LimitOrderDB
+	for _, o := range b.orders {
+		if o.Status == StatusOpen && !o.ExpiresAt.After(now) {
+			orders = append(orders, o)
+		}
+	}
+	return orders, nil
+}
+
+func (b *fakeBook) LockOpenOrder(_ context.Context, id uuid.UUID) (*LimitOrderDB, error) {
+	o, ok := b.orders[id]
+	if !ok || o.Status != StatusOpen {
+		return nil, utils.ErrorOrderNotFound
+	}
+	return o, nil
+}
+
+func (b *fakeBook) CloseOrder(_ context.Context, o *LimitOrderDB) error {
+	b.orders[o.ID] = o
+	return nil
+}
+
+func (b *fakeBook) PlaceHold(_ context.Context, _ uuid.UUID, currency string, amount float32) (uuid.UUID, error) {
+	w, ok := b.wallets[currency]
+	if !ok || w.balance-w.held < amount {
+		return uuid.Nil, utils.ErrorInsufficientFunds
+	}
+	w.held += amount
+	return w.id, nil
+}
+
+func (b *fakeBook) ReleaseHold(_ context.Context, walletID uuid.UUID, held, captured float32) error {
+	w := b.walletByID(walletID)
+	w.held -= held
+	w.balance -= captured
+	return nil
+}
+
+func (b *fakeBook) ExchangeHeld(_ context.Context, _, walletID uuid.UUID, rate *wallet.ExchangeRateToCurrency, to, from float32, _ string) (*models.CurrencyWalletDB, error) {
+	w := b.walletByID(walletID)
+	w.held -= from
+	w.balance -= from
+	target, ok := b.wallets[rate.ToCurrency]
+	if !ok {
+		target = &fakeWallet{id: uuid.New()}
+		b.wallets[rate.ToCurrency] = target
+	}
+	target.balance += to
+	balances := map[string]float32{}
+	for currency, w := range b.wallets {
+		balances[currency] = w.balance
+	}
+	return &models.CurrencyWalletDB{CurrencyWallet: models.CurrencyWallet{Balances: balances}}, nil
+}
+
+func (b *fakeBook) IsEmailVerified(context.Context, uuid.UUID) (bool, error) { return true, nil }
+
+func (b *fakeBook) RequireStepUp(context.Context, uuid.UUID, float32, string, string) error {
+	return nil
+}
+
+func (b *fakeBook) NotificationLocale(context.Context, uuid.UUID) (i18n.Locale, error) {
+	return i18n.Default, nil
+}
+
+func (b *fakeBook) CreateEvent(_ context.Context, eventType, _ string) (uuid.UUID, error) {
+	b.events = append(b.events, eventType)
+	return uuid.New(), nil
+}
+
+func (b *fakeBook) Record(_ context.Context, e audit.Entry) error {
+	b.audited = append(b.audited, e.Action)
+	return nil
+}
+
+func newTestService(b *fakeBook) *Service {
+	return NewService(b, b, nil, b, b, b, b, nil, 24*time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func testContext() context.Context {
+	return db.WithTx(context.Background(), fakeTx{})
+}
+
+// TestPlaceOrder — сумма ордера резервируется в held исходного кошелька, без свободных средств
+// ордер не создаётся и резерв не появляется.
+func TestPlaceOrder(t *testing.T) {
+	cases := []struct {
+		name      string
+		amount    float32
+		expiresIn time.Duration
+		wantErr   error
+		wantHeld  float32
+	}{
+		{"reserved", 60, time.Hour, nil, 60},
+		{"whole balance", 100, time.Hour, nil, 100},
+		{"insufficient funds", 100.01, time.Hour, utils.ErrorInsufficientFunds, 0},
+		{"expiry beyond max ttl", 10, 48 * time.Hour, utils.ErrorOrderExpiryInvalid, 0},
+		{"expiry in the past", 10, -time.Minute, utils.ErrorOrderExpiryInvalid, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := newFakeBook(map[string]float32{"USD": 100})
+			s := newTestService(b)
+			o, err := s.PlaceOrder(testContext(), b.userID, PlaceOrderRequest{
+				FromCurrency: "USD",
+				ToCurrency:   "EUR",
+				Amount:       c.amount,
+				TargetRate:   0.9,
+				ExpiresAt:    time.Now().Add(c.expiresIn),
+			})
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("PlaceOrder: %v, want %v", err, c.wantErr)
+			}
+			if held := b.wallets["USD"].held; held != c.wantHeld {
+				t.Errorf("held = %v, want %v", held, c.wantHeld)
+			}
+			if c.wantErr != nil {
+				if len(b.orders) != 0 {
+					t.Errorf("orders = %d, want none", len(b.orders))
+				}
+				return
+			}
+			if o.Status != StatusOpen || o.WalletID != b.wallets["USD"].id {
+				t.Errorf("order = %+v, want OPEN on the USD wallet", o)
+			}
+		})
+	}
+}
+
+// TestExecuteOrders — ордер исполняется, только если курс не ниже целевого и срок не истёк:
+// резерв списывается из held, сумма по курсу зачисляется в целевую валюту.
+func TestExecuteOrders(t *testing.T) {
+	cases := []struct {
+		name         string
+		rate         float32
+		expiresIn    time.Duration
+		closed       bool
+		wantStatus   Status
+		wantUSD      float32
+		wantHeld     float32
+		wantEUR      float32
+		wantExecuted bool
+	}{
+		{"rate above target", 0.95, time.Hour, false, StatusExecuted, 0, 0, 95, true},
+		{"rate at target", 0.9, time.Hour, false, StatusExecuted, 0, 0, 90, true},
+		{"rate below target", 0.85, time.Hour, false, StatusOpen, 100, 100, 0, false},
+		{"expired before the watcher closed it", 0.95, -time.Second, false, StatusOpen, 100, 100, 0, false},
+		{"already cancelled", 0.95, time.Hour, true, StatusCancelled, 100, 0, 0, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := newFakeBook(map[string]float32{"USD": 100, "EUR": 0})
+			s := newTestService(b)
+			o, err := b.CreateOrder(context.Background(), &LimitOrderDB{
+				UserID:       b.userID,
+				WalletID:     b.wallets["USD"].id,
+				FromCurrency: "USD",
+				ToCurrency:   "EUR",
+				Amount:       100,
+				TargetRate:   0.9,
+				ExpiresAt:    time.Now().Add(c.expiresIn),
+			})
+			if err != nil {
+				t.Fatalf("create order: %v", err)
+			}
+			if c.closed {
+				o.Status = StatusCancelled
+			} else {
+				b.wallets["USD"].held = 100
+			}
+
+			snapshot := &wallet.ExchangeRateToCurrency{FromCurrency: "USD", ToCurrency: "EUR", Rate: c.rate, SnapshotID: uuid.New()}
+			if err := s.executeOrders(testContext(), Pair{FromCurrency: "USD", ToCurrency: "EUR"}, snapshot, 10); err != nil {
+				t.Fatalf("executeOrders: %v", err)
+			}
+			if o.Status != c.wantStatus {
+				t.Errorf("status = %s, want %s", o.Status, c.wantStatus)
+			}
+			usd, eur := b.wallets["USD"], b.wallets["EUR"]
+			if usd.balance != c.wantUSD || usd.held != c.wantHeld || eur.balance != c.wantEUR {
+				t.Errorf("USD %v (held %v), EUR %v; want USD %v (held %v), EUR %v",
+					usd.balance, usd.held, eur.balance, c.wantUSD, c.wantHeld, c.wantEUR)
+			}
+			executed := len(b.audited) == 1 && b.audited[0] == audit.ActionOrderExecute &&
+				len(b.events) == 1 && b.events[0] == EventTypeExecuted
+			if executed != c.wantExecuted {
+				t.Errorf("events %v, audit %v; executed = %v, want %v", b.events, b.audited, executed, c.wantExecuted)
+			}
+			if c.wantExecuted && (o.ExecutedRate == nil || *o.ExecutedRate != c.rate) {
+				t.Errorf("executed rate = %v, want %v", o.ExecutedRate, c.rate)
+			}
+		})
+	}
+}
+
+// TestExpireOrders — просроченный ордер закрывается со снятием резерва, баланс не меняется;
+// действующий ордер остаётся открытым.
+func TestExpireOrders(t *testing.T) {
+	b := newFakeBook(map[string]float32{"USD": 100})
+	s := newTestService(b)
+	place := func(amount float32, expiresIn time.Duration) *LimitOrderDB {
+		t.Helper()
+		o, err := b.CreateOrder(context.Background(), &LimitOrderDB{
+			UserID:       b.userID,
+			WalletID:     b.wallets["USD"].id,
+			FromCurrency: "USD",
+			ToCurrency:   "EUR",
+			Amount:       amount,
+			TargetRate:   0.9,
+			ExpiresAt:    time.Now().Add(expiresIn),
+		})
+		if err != nil {
+			t.Fatalf("create order: %v", err)
+		}
+		b.wallets["USD"].held += amount
+		return o
+	}
+	expired := place(30, -time.Second)
+	open := place(50, time.Hour)
+
+	if err := s.expireOrders(testContext(), 10); err != nil {
+		t.Fatalf("expireOrders: %v", err)
+	}
+	if expired.Status != StatusExpired || expired.ClosedAt == nil {
+		t.Errorf("expired order: status %s, closed at %v", expired.Status, expired.ClosedAt)
+	}
+	if open.Status != StatusOpen {
+		t.Errorf("open order status = %s, want OPEN", open.Status)
+	}
+	if usd := b.wallets["USD"]; usd.balance != 100 || usd.held != 50 {
+		t.Errorf("USD %v (held %v), want 100 (held 50)", usd.balance, usd.held)
+	}
+	if len(b.events) != 1 || b.events[0] != EventTypeExpired {
+		t.Errorf("events = %v, want one %s", b.events, EventTypeExpired)
+	}
+
+	// повторный проход не трогает уже закрытый ордер
+	if err := s.expireOrders(testContext(), 10); err != nil {
+		t.Fatalf("second expireOrders: %v", err)
+	}
+	if usd := b.wallets["USD"]; usd.held != 50 || len(b.events) != 1 {
+		t.Errorf("second pass: held %v, events %v", usd.held, b.events)
+	}
+}
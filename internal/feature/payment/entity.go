@@ -0,0 +1,119 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	KindTopUp  Kind = "TOPUP"
+	KindPayout Kind = "PAYOUT"
+)
+
+// Status — состояние платежа: PENDING до подтверждения провайдером, дальше только COMPLETED или FAILED.
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusCompleted Status = "COMPLETED"
+	StatusFailed    Status = "FAILED"
+)
+
+// Типы событий outbox для завершения платежа.
+const (
+	EventTypeCompleted = "PAYMENT_COMPLETED"
+	EventTypeFailed    = "PAYMENT_FAILED"
+)
+
+// Коды уведомлений в каталоге i18n.
+const (
+	NotificationTopUpCompleted  = "notification.topup_completed"
+	NotificationTopUpFailed     = "notification.topup_failed"
+	NotificationPayoutCompleted = "notification.payout_completed"
+	NotificationPayoutFailed    = "notification.payout_failed"
+)
+
+type PaymentDB struct {
+	ID                uuid.UUID  `db:"id"`
+	UserID            uuid.UUID  `db:"user_id"`
+	Kind              Kind       `db:"kind"`
+	Provider          string     `db:"provider"`
+	ProviderReference *string    `db:"provider_reference"`
+	Currency          string     `db:"currency"`
+	Amount            float32    `db:"amount"`
+	Status            Status     `db:"status"`
+	Destination       *string    `db:"destination"`
+	RedirectURL       *string    `db:"redirect_url"`
+	FailureReason     *string    `db:"failure_reason"`
+	CompletedAt       *time.Time `db:"completed_at"`
+	CreatedAt         time.Time  `db:"created_at"`
+}
+
+type TopUpRequest struct {
+	Currency string  `json:"currency" validate:"required,currency"`
+	Amount   float32 `json:"amount" validate:"required,money"`
+}
+
+// PayoutRequest: destination — реквизиты получателя у провайдера (карта, счёт).
+type PayoutRequest struct {
+	Currency    string  `json:"currency" validate:"required,currency"`
+	Amount      float32 `json:"amount" validate:"required,money"`
+	Destination string  `json:"destination" validate:"required,max=128"`
+	TOTPCode    string  `json:"totp_code,omitempty"`
+}
+
+type Payment struct {
+	ID            uuid.UUID  `json:"id"`
+	Kind          Kind       `json:"kind"`
+	Provider      string     `json:"provider"`
+	Currency      string     `json:"currency"`
+	Amount        float32    `json:"amount"`
+	Status        Status     `json:"status"`
+	Destination   *string    `json:"destination,omitempty"`
+	RedirectURL   *string    `json:"redirect_url,omitempty"`
+	FailureReason *string    `json:"failure_reason,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func NewPayment(p *PaymentDB) Payment {
+	return Payment{
+		ID:            p.ID,
+		Kind:          p.Kind,
+		Provider:      p.Provider,
+		Currency:      p.Currency,
+		Amount:        p.Amount,
+		Status:        p.Status,
+		Destination:   p.Destination,
+		RedirectURL:   p.RedirectURL,
+		FailureReason: p.FailureReason,
+		CompletedAt:   p.CompletedAt,
+		CreatedAt:     p.CreatedAt,
+	}
+}
+
+type PaymentResponse struct {
+	api.Response
+	Payment Payment `json:"payment"`
+}
+
+type PaymentsResponse struct {
+	api.Response
+	Payments []Payment `json:"payments"`
+}
+
+type KafkaPayloadPayment struct {
+	PaymentID uuid.UUID `json:"payment_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Kind      Kind      `json:"kind"`
+	Status    Status    `json:"status"`
+	Currency  string    `json:"currency"`
+	Amount    float32   `json:"amount"`
+	Code      string    `json:"code"`
+	Locale    string    `json:"locale"`
+	Message   string    `json:"message"`
+}
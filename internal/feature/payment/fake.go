@@ -0,0 +1,141 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+const FakeProviderName = "fake"
+
+// FakeWebhookPayload — тело вебхука тестового провайдера.
+type FakeWebhookPayload struct {
+	PaymentID uuid.UUID `json:"payment_id"`
+	Reference string    `json:"reference"`
+	Status    Status    `json:"status" enums:"COMPLETED,FAILED"`
+	Currency  string    `json:"currency"`
+	Amount    float32   `json:"amount"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+type FakeOptions struct {
+	Secret []byte
+	// CallbackURL — адрес вебхука сервиса, на который провайдер присылает результат
+	CallbackURL string
+	// ConfirmDelay — через сколько после создания операции приходит вебхук
+	ConfirmDelay time.Duration
+	// DeclineAbove — операции на сумму больше этой отклоняются, 0 — принимаются все
+	DeclineAbove float32
+	// Tolerance — допустимое расхождение времени подписи вебхука
+	Tolerance time.Duration
+}
+
+// FakeProvider — провайдер для локальной разработки: сам подтверждает операции,
+// отправляя подписанный вебхук на CallbackURL, как это сделал бы настоящий провайдер.
+type FakeProvider struct {
+	opts   FakeOptions
+	client *http.Client
+	log    *slog.Logger
+}
+
+func NewFakeProvider(opts FakeOptions, log *slog.Logger) *FakeProvider {
+	return &FakeProvider{
+		opts:   opts,
+		client: &http.Client{Timeout: 10 * time.Second},
+		log:    log,
+	}
+}
+
+func (f *FakeProvider) Name() string {
+	return FakeProviderName
+}
+
+func (f *FakeProvider) CreateTopUp(ctx context.Context, p *PaymentDB) (*ProviderResult, error) {
+	ref := "fake_" + uuid.NewString()
+	f.confirmLater(p, ref)
+	return &ProviderResult{
+		Reference:   ref,
+		RedirectURL: "https://pay.fake-provider.local/checkout/" + ref,
+	}, nil
+}
+
+func (f *FakeProvider) CreatePayout(ctx context.Context, p *PaymentDB) (*ProviderResult, error) {
+	ref := "fake_" + uuid.NewString()
+	f.confirmLater(p, ref)
+	return &ProviderResult{Reference: ref}, nil
+}
+
+func (f *FakeProvider) ParseWebhook(header http.Header, body []byte) (*WebhookEvent, error) {
+	if err := VerifyWebhook(f.opts.Secret, header, body, f.opts.Tolerance, time.Now()); err != nil {
+		return nil, err
+	}
+	var payload FakeWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, utils.ErrorInvalidRequestBody
+	}
+	if payload.Status != StatusCompleted && payload.Status != StatusFailed {
+		return nil, utils.ErrorInvalidRequestBody
+	}
+	return &WebhookEvent{
+		PaymentID: payload.PaymentID,
+		Reference: payload.Reference,
+		Status:    payload.Status,
+		Currency:  payload.Currency,
+		Amount:    payload.Amount,
+		Reason:    payload.Reason,
+	}, nil
+}
+
+// confirmLater в фоне отправляет вебхук с результатом операции.
+// Ошибки доставки только логируются: как и у настоящего провайдера, платёж остаётся в PENDING.
+func (f *FakeProvider) confirmLater(p *PaymentDB, ref string) {
+	payload := FakeWebhookPayload{
+		PaymentID: p.ID,
+		Reference: ref,
+		Status:    StatusCompleted,
+		Currency:  p.Currency,
+		Amount:    p.Amount,
+	}
+	if f.opts.DeclineAbove > 0 && p.Amount > f.opts.DeclineAbove {
+		payload.Status = StatusFailed
+		payload.Reason = "declined by fake provider"
+	}
+	go func() {
+		time.Sleep(f.opts.ConfirmDelay)
+		if err := f.send(payload); err != nil {
+			f.log.Error("fake provider failed to deliver webhook", slog.String("payment_id", p.ID.String()), logger.Err(err))
+		}
+	}()
+}
+
+func (f *FakeProvider) send(payload FakeWebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, f.opts.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookTimestamp, fmt.Sprint(timestamp))
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(f.opts.Secret, timestamp, body))
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	httphandlers "github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+)
+
+type HandlerPayments interface {
+	CreateTopUp(ctx context.Context, userID uuid.UUID, req TopUpRequest) (*PaymentDB, error)
+	CreatePayout(ctx context.Context, userID uuid.UUID, req PayoutRequest) (*PaymentDB, error)
+	GetPayment(ctx context.Context, userID, id uuid.UUID) (*PaymentDB, error)
+	ListPayments(ctx context.Context, userID uuid.UUID, kind *Kind, status *Status) ([]*PaymentDB, error)
+	HandleWebhook(ctx context.Context, provider string, header http.Header, body []byte) error
+}
+
+type Handler struct {
+	s   HandlerPayments
+	log *slog.Logger
+}
+
+func NewHandler(s HandlerPayments, log *slog.Logger) *Handler {
+	return &Handler{
+		s:   s,
+		log: log,
+	}
+}
+
+// writeError отвечает доменной ошибкой, в лог пишутся только непредвиденные ошибки.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	if utils.AsError(err).Kind == utils.KindInternal {
+		log.Error("request failed", logger.Err(err))
+	}
+	api.WriteError(w, r, err)
+}
+
+// @Summary CreateTopUp
+// @ID createTopUp
+// @Tags payments
+// @Description start a top-up through the payment provider. The wallet is credited only after
+// @Description the provider confirms the payment, the client should send the user to redirect_url
+// @Accept json
+// @Produce json
+// @Param input body TopUpRequest true "top-up"
+// @Success 201 {object}  PaymentResponse
+// @Failure 400,401,413 {object}  api.Response
+// @Failure 500,503 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /payments/topups [post]
+func (h *Handler) CreateTopUpHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Payment.Handler.CreateTopUp"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req TopUpRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	p, err := h.s.CreateTopUp(r.Context(), claims.ID, req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, PaymentResponse{
+		Response: api.OK(),
+		Payment:  NewPayment(p),
+	})
+}
+
+// @Summary CreatePayout
+// @ID createPayout
+// @Tags payments
+// @Description send money from a currency wallet to destination through the payment provider.
+// @Description The amount is debited immediately and returned if the provider declines the payout.
+// @Description Large amounts require totp_code
+// @Accept json
+// @Produce json
+// @Param input body PayoutRequest true "payout"
+// @Success 201 {object}  PaymentResponse
+// @Failure 400,401,403,404,413,422 {object}  api.Response
+// @Failure 500,503 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /payments/payouts [post]
+func (h *Handler) CreatePayoutHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Payment.Handler.CreatePayout"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req PayoutRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	p, err := h.s.CreatePayout(r.Context(), claims.ID, req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, PaymentResponse{
+		Response: api.OK(),
+		Payment:  NewPayment(p),
+	})
+}
+
+// @Summary ListPayments
+// @ID listPayments
+// @Tags payments
+// @Description top-ups and payouts of the current user, newest first
+// @Produce json
+// @Param kind query string false "filter by kind" Enums(TOPUP, PAYOUT)
+// @Param status query string false "filter by status" Enums(PENDING, COMPLETED, FAILED)
+// @Success 200 {object}  PaymentsResponse
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /payments [get]
+func (h *Handler) ListPaymentsHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Payment.Handler.ListPayments"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var fields []api.FieldError
+	var kind *Kind
+	if v := r.URL.Query().Get("kind"); v != "" {
+		k := Kind(v)
+		switch k {
+		case KindTopUp, KindPayout:
+			kind = &k
+		default:
+			fields = append(fields, api.FieldError{Field: "kind", Rule: "oneof", Param: "TOPUP PAYOUT"})
+		}
+	}
+	var status *Status
+	if v := r.URL.Query().Get("status"); v != "" {
+		st := Status(v)
+		switch st {
+		case StatusPending, StatusCompleted, StatusFailed:
+			status = &st
+		default:
+			fields = append(fields, api.FieldError{Field: "status", Rule: "oneof", Param: "PENDING COMPLETED FAILED"})
+		}
+	}
+	if len(fields) > 0 {
+		api.WriteError(w, r, &api.ValidationError{Fields: fields})
+		return
+	}
+	payments, err := h.s.ListPayments(r.Context(), claims.ID, kind, status)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	resp := PaymentsResponse{
+		Response: api.OK(),
+		Payments: make([]Payment, 0, len(payments)),
+	}
+	for _, p := range payments {
+		resp.Payments = append(resp.Payments, NewPayment(p))
+	}
+	render.JSON(w, r, resp)
+}
+
+// @Summary GetPayment
+// @ID getPayment
+// @Tags payments
+// @Description top-up or payout of the current user
+// @Produce json
+// @Param id path string true "payment id"
+// @Success 200 {object}  PaymentResponse
+// @Failure 400,401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /payments/{id} [get]
+func (h *Handler) GetPaymentHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Payment.Handler.GetPayment"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidPaymentID)
+		return
+	}
+	p, err := h.s.GetPayment(r.Context(), claims.ID, id)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, PaymentResponse{
+		Response: api.OK(),
+		Payment:  NewPayment(p),
+	})
+}
+
+// @Summary PaymentWebhook
+// @ID paymentWebhook
+// @Tags payments
+// @Description callback of the payment provider with the result of a top-up or payout.
+// @Description The body is signed: X-Webhook-Signature is hex(HMAC-SHA256(secret, X-Webhook-Timestamp + "." + body)).
+// @Description Repeated callbacks with the same result are accepted and ignored
+// @Accept json
+// @Produce json
+// @Param provider path string true "provider name" Enums(fake)
+// @Param X-Webhook-Timestamp header string true "unix time of signing"
+// @Param X-Webhook-Signature header string true "body signature"
+// @Param input body FakeWebhookPayload true "payment result"
+// @Success 200 {object}  api.Response
+// @Failure 400,401,404,409,413,422 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Router /payments/webhooks/{provider} [post]
+func (h *Handler) WebhookHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Payment.Handler.Webhook"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, request.DefaultMaxBodyBytes))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			api.WriteError(w, r, utils.ErrorRequestTooLarge)
+			return
+		}
+		api.WriteError(w, r, utils.ErrorInvalidRequestBody)
+		return
+	}
+	if err := h.s.HandleWebhook(r.Context(), chi.URLParam(r, "provider"), r.Header, body); err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
@@ -0,0 +1,74 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+// PaymentProvider — внешний платёжный провайдер. Создание операции у провайдера только
+// запускает её, результат приходит позже подписанным вебхуком.
+type PaymentProvider interface {
+	Name() string
+	// CreateTopUp регистрирует пополнение и возвращает ссылку, по которой пользователь его оплачивает.
+	CreateTopUp(ctx context.Context, p *PaymentDB) (*ProviderResult, error)
+	// CreatePayout отправляет выплату на реквизиты p.Destination.
+	CreatePayout(ctx context.Context, p *PaymentDB) (*ProviderResult, error)
+	// ParseWebhook проверяет подпись вебхука и разбирает его тело.
+	// При неверной подписи возвращает utils.ErrorInvalidWebhookSignature.
+	ParseWebhook(header http.Header, body []byte) (*WebhookEvent, error)
+}
+
+type ProviderResult struct {
+	Reference   string
+	RedirectURL string
+}
+
+// WebhookEvent — итог операции у провайдера. PaymentID — наш идентификатор,
+// который провайдер получил при создании операции и возвращает обратно.
+type WebhookEvent struct {
+	PaymentID uuid.UUID
+	Reference string
+	Status    Status
+	Currency  string
+	Amount    float32
+	Reason    string
+}
+
+const (
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// SignWebhook считает подпись тела вебхука: hex(HMAC-SHA256(secret, timestamp + "." + body)).
+func SignWebhook(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook проверяет подпись и возраст вебхука, старые запросы отклоняются, чтобы их нельзя было переиграть.
+func VerifyWebhook(secret []byte, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get(HeaderWebhookTimestamp), 10, 64)
+	if err != nil {
+		return utils.ErrorInvalidWebhookSignature
+	}
+	sent := time.Unix(timestamp, 0)
+	if now.Sub(sent) > tolerance || sent.Sub(now) > tolerance {
+		return utils.ErrorInvalidWebhookSignature
+	}
+	expected := SignWebhook(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(HeaderWebhookSignature))) {
+		return utils.ErrorInvalidWebhookSignature
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+const paymentColumns = "id, user_id, kind, provider, provider_reference, currency, amount, status, destination, redirect_url, failure_reason, completed_at, created_at"
+
+func scanPayment(row pgx.Row) (*PaymentDB, error) {
+	var p PaymentDB
+	if err := row.Scan(&p.ID, &p.UserID, &p.Kind, &p.Provider, &p.ProviderReference, &p.Currency, &p.Amount, &p.Status,
+		&p.Destination, &p.RedirectURL, &p.FailureReason, &p.CompletedAt, &p.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *Repository) CreatePayment(ctx context.Context, p *PaymentDB) (*PaymentDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Insert("payments").
+		Columns("user_id", "kind", "provider", "currency", "amount", "destination").
+		Values(p.UserID, p.Kind, p.Provider, p.Currency, p.Amount, p.Destination).
+		Suffix("RETURNING " + paymentColumns).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	return scanPayment(conn.QueryRow(ctx, query, arg...))
+}
+
+// SetProviderResult сохраняет идентификатор операции у провайдера и ссылку на оплату.
+func (r *Repository) SetProviderResult(ctx context.Context, id uuid.UUID, res *ProviderResult) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	var redirectURL *string
+	if res.RedirectURL != "" {
+		redirectURL = &res.RedirectURL
+	}
+	query, arg, err := sq.
+		Update("payments").
+		Set("provider_reference", sq.Expr("COALESCE(provider_reference, ?)", res.Reference)).
+		Set("redirect_url", redirectURL).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, arg...)
+	return err
+}
+
+func (r *Repository) GetPayment(ctx context.Context, id, userID uuid.UUID) (*PaymentDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select(paymentColumns).
+		From("payments").
+		Where(sq.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	p, err := scanPayment(conn.QueryRow(ctx, query, arg...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorPaymentNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *Repository) ListPayments(ctx context.Context, userID uuid.UUID, kind *Kind, status *Status) ([]*PaymentDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	builder := sq.
+		Select(paymentColumns).
+		From("payments").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(sq.Dollar)
+	if kind != nil {
+		builder = builder.Where(sq.Eq{"kind": *kind})
+	}
+	if status != nil {
+		builder = builder.Where(sq.Eq{"status": *status})
+	}
+	query, arg, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	payments := make([]*PaymentDB, 0)
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// LockPayment блокирует платёж провайдера до конца транзакции, чтобы повторный вебхук ждал первый.
+func (r *Repository) LockPayment(ctx context.Context, id uuid.UUID, provider string) (*PaymentDB, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, arg, err := sq.
+		Select(paymentColumns).
+		From("payments").
+		Where(sq.Eq{"id": id, "provider": provider}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	p, err := scanPayment(tx.QueryRow(ctx, query, arg...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorPaymentNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// ClosePayment переводит платёж из PENDING в конечное состояние.
+func (r *Repository) ClosePayment(ctx context.Context, p *PaymentDB) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("payments").
+		Set("status", p.Status).
+		Set("provider_reference", sq.Expr("COALESCE(provider_reference, ?)", p.ProviderReference)).
+		Set("failure_reason", p.FailureReason).
+		Set("completed_at", p.CompletedAt).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": p.ID, "status": StatusPending}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorPaymentClosed
+	}
+	return nil
+}
@@ -0,0 +1,339 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"time"
+
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type ServicePayments interface {
+	CreatePayment(ctx context.Context, p *PaymentDB) (*PaymentDB, error)
+	SetProviderResult(ctx context.Context, id uuid.UUID, res *ProviderResult) error
+	GetPayment(ctx context.Context, id, userID uuid.UUID) (*PaymentDB, error)
+	ListPayments(ctx context.Context, userID uuid.UUID, kind *Kind, status *Status) ([]*PaymentDB, error)
+	LockPayment(ctx context.Context, id uuid.UUID, provider string) (*PaymentDB, error)
+	ClosePayment(ctx context.Context, p *PaymentDB) error
+}
+
+type ServiceWallets interface {
+	DepositOrWithdrawBalance(
+		ctx context.Context,
+		id uuid.UUID,
+		amount float32,
+		currency string,
+		typedepo contextkey.OperationType,
+	) (*models.CurrencyWalletDB, error)
+	SetTransaction(
+		ctx context.Context,
+		walletID uuid.UUID,
+		amount float32,
+		typetransaction contextkey.OperationType,
+		senderID *uuid.UUID,
+		idempotencyKey string,
+	) error
+}
+
+type ServiceUsers interface {
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	RequireStepUp(ctx context.Context, userID uuid.UUID, amount float32, currency, code string) error
+	NotificationLocale(ctx context.Context, userID uuid.UUID) (i18n.Locale, error)
+}
+
+type ServiceEvents interface {
+	CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error)
+}
+
+type ServiceAudit interface {
+	Record(ctx context.Context, e audit.Entry) error
+}
+
+type Service struct {
+	repository ServicePayments
+	wallets    ServiceWallets
+	users      ServiceUsers
+	events     ServiceEvents
+	audit      ServiceAudit
+	provider   PaymentProvider
+	tx         *db.TxManager
+	log        *slog.Logger
+}
+
+func NewService(r ServicePayments, wallets ServiceWallets, users ServiceUsers, events ServiceEvents, audit ServiceAudit, provider PaymentProvider, tx *db.TxManager, log *slog.Logger) *Service {
+	return &Service{
+		repository: r,
+		wallets:    wallets,
+		users:      users,
+		events:     events,
+		audit:      audit,
+		provider:   provider,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+// CreateTopUp регистрирует пополнение у провайдера. Кошелёк не меняется,
+// деньги зачисляются только после вебхука провайдера об успешной оплате.
+func (s *Service) CreateTopUp(ctx context.Context, userID uuid.UUID, req TopUpRequest) (*PaymentDB, error) {
+	const op = "Payment.Service.CreateTopUp"
+	log := s.log.With(slog.String("op", op))
+
+	if !contextkey.IsKnownCurrency(req.Currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	var p *PaymentDB
+	err := s.tx.Do(ctx, func(ctx context.Context) (err error) {
+		p, err = s.repository.CreatePayment(ctx, &PaymentDB{
+			UserID:   userID,
+			Kind:     KindTopUp,
+			Provider: s.provider.Name(),
+			Currency: req.Currency,
+			Amount:   req.Amount,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to create top-up", logger.Err(err))
+		return nil, err
+	}
+	return s.submit(ctx, p, s.provider.CreateTopUp)
+}
+
+// CreatePayout сразу списывает сумму с кошелька и отправляет выплату провайдеру.
+// Если провайдер выплату отклонит, сумма вернётся на кошелёк.
+func (s *Service) CreatePayout(ctx context.Context, userID uuid.UUID, req PayoutRequest) (*PaymentDB, error) {
+	const op = "Payment.Service.CreatePayout"
+	log := s.log.With(slog.String("op", op))
+
+	if !contextkey.IsKnownCurrency(req.Currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	verified, err := s.users.IsEmailVerified(ctx, userID)
+	if err != nil {
+		log.Error("failed to check email verification", logger.Err(err))
+		return nil, err
+	}
+	if !verified {
+		return nil, utils.ErrorEmailNotVerified
+	}
+	if err := s.users.RequireStepUp(ctx, userID, req.Amount, req.Currency, req.TOTPCode); err != nil {
+		return nil, err
+	}
+
+	var p *PaymentDB
+	err = s.tx.Do(ctx, func(ctx context.Context) (err error) {
+		p, err = s.repository.CreatePayment(ctx, &PaymentDB{
+			UserID:      userID,
+			Kind:        KindPayout,
+			Provider:    s.provider.Name(),
+			Currency:    req.Currency,
+			Amount:      req.Amount,
+			Destination: &req.Destination,
+		})
+		if err != nil {
+			return err
+		}
+		data, err := s.wallets.DepositOrWithdrawBalance(ctx, userID, p.Amount, p.Currency, contextkey.OperationTypeWithdraw)
+		if err != nil {
+			return err
+		}
+		if err := s.wallets.SetTransaction(ctx, data.WalletID, p.Amount, contextkey.OperationTypeWithdraw, nil, "payment:"+p.ID.String()+":payout"); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionPayout,
+			ActorID:  &userID,
+			TargetID: &userID,
+			Before:   map[string]float32{p.Currency: data.Balances[p.Currency] + p.Amount},
+			After:    map[string]float32{p.Currency: data.Balances[p.Currency]},
+		})
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to create payout", logger.Err(err))
+		}
+		return nil, err
+	}
+	return s.submit(ctx, p, s.provider.CreatePayout)
+}
+
+func (s *Service) GetPayment(ctx context.Context, userID, id uuid.UUID) (*PaymentDB, error) {
+	return s.repository.GetPayment(ctx, id, userID)
+}
+
+func (s *Service) ListPayments(ctx context.Context, userID uuid.UUID, kind *Kind, status *Status) ([]*PaymentDB, error) {
+	return s.repository.ListPayments(ctx, userID, kind, status)
+}
+
+// HandleWebhook применяет результат операции от провайдера. Повтор того же вебхука
+// ничего не меняет, противоречащий уже записанному результату отклоняется.
+func (s *Service) HandleWebhook(ctx context.Context, provider string, header http.Header, body []byte) error {
+	const op = "Payment.Service.HandleWebhook"
+	log := s.log.With(slog.String("op", op))
+
+	if provider != s.provider.Name() {
+		return utils.ErrorUnknownPaymentProvider
+	}
+	ev, err := s.provider.ParseWebhook(header, body)
+	if err != nil {
+		return err
+	}
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		p, err := s.repository.LockPayment(ctx, ev.PaymentID, provider)
+		if err != nil {
+			return err
+		}
+		if p.Status != StatusPending {
+			if p.Status == ev.Status {
+				return nil
+			}
+			return utils.ErrorPaymentClosed
+		}
+		if ev.Currency != p.Currency || cents(ev.Amount) != cents(p.Amount) ||
+			(p.ProviderReference != nil && *p.ProviderReference != ev.Reference) {
+			return utils.ErrorPaymentMismatch
+		}
+		p.ProviderReference = &ev.Reference
+		return s.settle(ctx, p, ev.Status, ev.Reason)
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to handle webhook", logger.Err(err))
+		} else {
+			log.Warn("webhook rejected", slog.String("payment_id", ev.PaymentID.String()), logger.Err(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// submit передаёт операцию провайдеру. Если провайдер её не принял, платёж сразу закрывается как FAILED.
+func (s *Service) submit(ctx context.Context, p *PaymentDB, create func(ctx context.Context, p *PaymentDB) (*ProviderResult, error)) (*PaymentDB, error) {
+	res, err := create(ctx, p)
+	if err != nil {
+		s.log.Error("payment provider rejected operation", slog.String("payment_id", p.ID.String()), logger.Err(err))
+		failErr := s.tx.Do(ctx, func(ctx context.Context) error {
+			locked, err := s.repository.LockPayment(ctx, p.ID, p.Provider)
+			if err != nil {
+				return err
+			}
+			if locked.Status != StatusPending {
+				return nil
+			}
+			return s.settle(ctx, locked, StatusFailed, "provider unavailable")
+		})
+		if failErr != nil {
+			s.log.Error("failed to close rejected payment", slog.String("payment_id", p.ID.String()), logger.Err(failErr))
+		}
+		return nil, utils.ErrorPaymentProviderUnavailable
+	}
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		return s.repository.SetProviderResult(ctx, p.ID, res)
+	})
+	if err != nil {
+		s.log.Error("failed to save provider result", slog.String("payment_id", p.ID.String()), logger.Err(err))
+		return nil, err
+	}
+	p.ProviderReference = &res.Reference
+	if res.RedirectURL != "" {
+		p.RedirectURL = &res.RedirectURL
+	}
+	return p, nil
+}
+
+// settle закрывает платёж и двигает деньги: успешное пополнение зачисляется,
+// отклонённая выплата возвращается на кошелёк.
+func (s *Service) settle(ctx context.Context, p *PaymentDB, status Status, reason string) error {
+	now := time.Now().UTC()
+	p.Status = status
+	p.CompletedAt = &now
+	if status == StatusFailed && reason != "" {
+		p.FailureReason = &reason
+	}
+	if err := s.repository.ClosePayment(ctx, p); err != nil {
+		return err
+	}
+	switch {
+	case p.Kind == KindTopUp && status == StatusCompleted:
+		if err := s.credit(ctx, p, "payment:"+p.ID.String()+":topup", audit.ActionTopUp); err != nil {
+			return err
+		}
+	case p.Kind == KindPayout && status == StatusFailed:
+		if err := s.credit(ctx, p, "payment:"+p.ID.String()+":refund", audit.ActionRefund); err != nil {
+			return err
+		}
+	}
+	return s.emit(ctx, p)
+}
+
+// credit зачисляет сумму платежа на кошелёк. Зачисление инициирует провайдер, поэтому исполнитель
+// в журнале — provider:<имя>.
+func (s *Service) credit(ctx context.Context, p *PaymentDB, idempotencyKey string, action audit.Action) error {
+	data, err := s.wallets.DepositOrWithdrawBalance(ctx, p.UserID, p.Amount, p.Currency, contextkey.OperationTypeDeposit)
+	if err != nil {
+		return err
+	}
+	if err := s.wallets.SetTransaction(ctx, data.WalletID, p.Amount, contextkey.OperationTypeDeposit, nil, idempotencyKey); err != nil {
+		return err
+	}
+	return s.audit.Record(ctx, audit.Entry{
+		Action:   action,
+		Actor:    "provider:" + p.Provider,
+		TargetID: &p.UserID,
+		Before:   map[string]float32{p.Currency: data.Balances[p.Currency] - p.Amount},
+		After:    map[string]float32{p.Currency: data.Balances[p.Currency]},
+	})
+}
+
+func (s *Service) emit(ctx context.Context, p *PaymentDB) error {
+	eventType, code := EventTypeCompleted, NotificationTopUpCompleted
+	switch {
+	case p.Kind == KindTopUp && p.Status == StatusFailed:
+		eventType, code = EventTypeFailed, NotificationTopUpFailed
+	case p.Kind == KindPayout && p.Status == StatusCompleted:
+		code = NotificationPayoutCompleted
+	case p.Kind == KindPayout && p.Status == StatusFailed:
+		eventType, code = EventTypeFailed, NotificationPayoutFailed
+	}
+	// результат платежа применяется в любом случае: вебхук, который падает на уведомлении, провайдер повторял бы бесконечно
+	locale, err := s.users.NotificationLocale(ctx, p.UserID)
+	if errors.Is(err, utils.ErrorUserNotFound) {
+		locale, err = i18n.FromContext(ctx), nil
+	}
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(KafkaPayloadPayment{
+		PaymentID: p.ID,
+		UserID:    p.UserID,
+		Kind:      p.Kind,
+		Status:    p.Status,
+		Currency:  p.Currency,
+		Amount:    p.Amount,
+		Code:      code,
+		Locale:    string(locale),
+		Message:   i18n.Message(locale, code, p.Amount, p.Currency),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.events.CreateEvent(ctx, eventType, string(payload))
+	return err
+}
+
+// cents сравнивает суммы с точностью до копеек, как они хранятся в базе.
+func cents(amount float32) int64 {
+	return int64(math.Round(float64(amount) * 100))
+}
@@ -0,0 +1,188 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// fakeTx — транзакция в контексте: репозиторий поддельный, её методы не вызываются.
+type fakeTx struct{ pgx.Tx }
+
+// fakeLedger хранит один платёж и баланс пользователя в памяти и подменяет репозиторий платежей,
+// кошельки, пользователей, outbox и журнал аудита.
+type fakeLedger struct {
+	ServicePayments
+	payment *PaymentDB
+	balance float32
+	credits []string
+	events  []string
+}
+
+func (l *fakeLedger) LockPayment(_ context.Context, id uuid.UUID, provider string) (*PaymentDB, error) {
+	if id != l.payment.ID || provider != l.payment.Provider {
+		return nil, utils.ErrorPaymentNotFound
+	}
+	return l.payment, nil
+}
+
+func (l *fakeLedger) ClosePayment(_ context.Context, p *PaymentDB) error {
+	l.payment = p
+	return nil
+}
+
+func (l *fakeLedger) DepositOrWithdrawBalance(_ context.Context, _ uuid.UUID, amount float32, currency string, _ contextkey.OperationType) (*models.CurrencyWalletDB, error) {
+	l.balance += amount
+	return &models.CurrencyWalletDB{CurrencyWallet: models.CurrencyWallet{Balances: map[string]float32{currency: l.balance}}}, nil
+}
+
+func (l *fakeLedger) SetTransaction(_ context.Context, _ uuid.UUID, _ float32, _ contextkey.OperationType, _ *uuid.UUID, idempotencyKey string) error {
+	l.credits = append(l.credits, idempotencyKey)
+	return nil
+}
+
+func (l *fakeLedger) IsEmailVerified(context.Context, uuid.UUID) (bool, error) { return true, nil }
+
+func (l *fakeLedger) RequireStepUp(context.Context, uuid.UUID, float32, string, string) error {
+	return nil
+}
+
+func (l *fakeLedger) NotificationLocale(context.Context, uuid.UUID) (i18n.Locale, error) {
+	return i18n.Default, nil
+}
+
+func (l *fakeLedger) CreateEvent(_ context.Context, eventType, _ string) (uuid.UUID, error) {
+	l.events = append(l.events, eventType)
+	return uuid.New(), nil
+}
+
+func (l *fakeLedger) Record(context.Context, audit.Entry) error { return nil }
+
+var webhookSecret = []byte("webhook-secret")
+
+// signedWebhook — тело и заголовки вебхука тестового провайдера, подписанные webhookSecret.
+func signedWebhook(t *testing.T, payload FakeWebhookPayload) (http.Header, []byte) {
+	t.Helper()
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("marshal webhook: %v", err)
+	}
+	timestamp := time.Now().Unix()
+	header := http.Header{}
+	header.Set(HeaderWebhookTimestamp, fmt.Sprint(timestamp))
+	header.Set(HeaderWebhookSignature, SignWebhook(webhookSecret, timestamp, body))
+	return header, body
+}
+
+// TestHandleWebhook — повтор вебхука ничего не меняет, а вебхук, противоречащий уже записанному
+// результату или данным платежа, отклоняется: деньги двигаются ровно один раз.
+func TestHandleWebhook(t *testing.T) {
+	type webhook struct {
+		status    Status
+		amount    float32
+		reference string
+		want      error
+	}
+	cases := []struct {
+		name        string
+		kind        Kind
+		webhooks    []webhook
+		wantStatus  Status
+		wantBalance float32
+		wantEvents  int
+	}{
+		{"top-up completed", KindTopUp, []webhook{
+			{StatusCompleted, 50, "ref_1", nil},
+		}, StatusCompleted, 50, 1},
+		{"top-up replay", KindTopUp, []webhook{
+			{StatusCompleted, 50, "ref_1", nil},
+			{StatusCompleted, 50, "ref_1", nil},
+			{StatusCompleted, 50, "ref_1", nil},
+		}, StatusCompleted, 50, 1},
+		{"failed top-up replay", KindTopUp, []webhook{
+			{StatusFailed, 50, "ref_1", nil},
+			{StatusFailed, 50, "ref_1", nil},
+		}, StatusFailed, 0, 1},
+		{"failure after completion", KindTopUp, []webhook{
+			{StatusCompleted, 50, "ref_1", nil},
+			{StatusFailed, 50, "ref_1", utils.ErrorPaymentClosed},
+		}, StatusCompleted, 50, 1},
+		{"completion after failure", KindTopUp, []webhook{
+			{StatusFailed, 50, "ref_1", nil},
+			{StatusCompleted, 50, "ref_1", utils.ErrorPaymentClosed},
+		}, StatusFailed, 0, 1},
+		{"amount differs", KindTopUp, []webhook{
+			{StatusCompleted, 60, "ref_1", utils.ErrorPaymentMismatch},
+		}, StatusPending, 0, 0},
+		{"reference differs", KindTopUp, []webhook{
+			{StatusCompleted, 50, "ref_2", utils.ErrorPaymentMismatch},
+		}, StatusPending, 0, 0},
+		{"payout refund replay", KindPayout, []webhook{
+			{StatusFailed, 50, "ref_1", nil},
+			{StatusFailed, 50, "ref_1", nil},
+		}, StatusFailed, 50, 1},
+		{"payout completion after refund", KindPayout, []webhook{
+			{StatusFailed, 50, "ref_1", nil},
+			{StatusCompleted, 50, "ref_1", utils.ErrorPaymentClosed},
+		}, StatusFailed, 50, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ref := "ref_1"
+			l := &fakeLedger{payment: &PaymentDB{
+				ID:                uuid.New(),
+				UserID:            uuid.New(),
+				Kind:              c.kind,
+				Provider:          FakeProviderName,
+				ProviderReference: &ref,
+				Currency:          "USD",
+				Amount:            50,
+				Status:            StatusPending,
+			}}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			provider := NewFakeProvider(FakeOptions{Secret: webhookSecret, Tolerance: time.Minute}, log)
+			s := NewService(l, l, l, l, l, provider, nil, log)
+			ctx := db.WithTx(context.Background(), fakeTx{})
+
+			for i, w := range c.webhooks {
+				header, body := signedWebhook(t, FakeWebhookPayload{
+					PaymentID: l.payment.ID,
+					Reference: w.reference,
+					Status:    w.status,
+					Currency:  "USD",
+					Amount:    w.amount,
+				})
+				if err := s.HandleWebhook(ctx, FakeProviderName, header, body); !errors.Is(err, w.want) {
+					t.Fatalf("webhook %d (%s): %v, want %v", i+1, w.status, err, w.want)
+				}
+			}
+			if l.payment.Status != c.wantStatus {
+				t.Errorf("status = %s, want %s", l.payment.Status, c.wantStatus)
+			}
+			if l.balance != c.wantBalance {
+				t.Errorf("balance = %v, want %v", l.balance, c.wantBalance)
+			}
+			if want := int(c.wantBalance / 50); len(l.credits) != want {
+				t.Errorf("credits = %v, want %d", l.credits, want)
+			}
+			if len(l.events) != c.wantEvents {
+				t.Errorf("events = %v, want %d", l.events, c.wantEvents)
+			}
+		})
+	}
+}
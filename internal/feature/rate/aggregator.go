@@ -0,0 +1,267 @@
+package rate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+)
+
+// Режимы выбора курса из нескольких провайдеров.
+const (
+	// ModeFailover берёт курс первого по приоритету провайдера, который ответил корректным и свежим значением.
+	ModeFailover = "failover"
+	// ModeConsensus опрашивает всех провайдеров и берёт медиану значений, согласных между собой.
+	ModeConsensus = "consensus"
+)
+
+// Source — провайдер в составе Aggregator. Меньший Priority опрашивается раньше.
+type Source struct {
+	Provider RateProvider
+	Priority int
+	// MaxAge — курс старше считается устаревшим и не используется, 0 — без проверки
+	MaxAge time.Duration
+}
+
+type AggregatorOptions struct {
+	Mode string
+	// Base — валюта, к которой приводятся курсы разных провайдеров перед сравнением
+	Base string
+	// Tolerance — допустимое относительное отклонение от медианы в режиме консенсуса
+	Tolerance float64
+	// Quorum — сколько провайдеров должны сойтись, чтобы курс был принят
+	Quorum int
+}
+
+// Aggregator объединяет несколько провайдеров в один RateProvider.
+// В режиме консенсуса пара, по которой провайдеры расходятся, останавливается: курс не отдаётся,
+// и обмены по ней отклоняются с utils.ErrorRatePairHalted, пока значения снова не сойдутся.
+type Aggregator struct {
+	sources []Source
+	opts    AggregatorOptions
+	log     *slog.Logger
+}
+
+func NewAggregator(sources []Source, opts AggregatorOptions, log *slog.Logger) (*Aggregator, error) {
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no rate providers configured")
+	}
+	if opts.Base == "" {
+		return nil, fmt.Errorf("rate providers base currency is not set")
+	}
+	switch opts.Mode {
+	case ModeFailover:
+	case ModeConsensus:
+		if opts.Quorum < 1 || opts.Quorum > len(sources) {
+			return nil, fmt.Errorf("rate providers quorum %d must be between 1 and %d", opts.Quorum, len(sources))
+		}
+		if opts.Tolerance <= 0 {
+			return nil, fmt.Errorf("rate providers tolerance must be positive")
+		}
+	default:
+		return nil, fmt.Errorf("unknown rate providers mode %q", opts.Mode)
+	}
+	sorted := slices.Clone(sources)
+	slices.SortStableFunc(sorted, func(a, b Source) int { return a.Priority - b.Priority })
+	return &Aggregator{sources: sorted, opts: opts, log: log}, nil
+}
+
+func (a *Aggregator) Name() string {
+	return "aggregator"
+}
+
+// Rates возвращает курсы относительно opts.Base. В режиме консенсуса валюты,
+// по которым провайдеры не сошлись, в ответ не попадают.
+func (a *Aggregator) Rates(ctx context.Context) (*Quote, error) {
+	const op = "Rate.Aggregator.Rates"
+	log := a.log.With(slog.String("op", op))
+
+	if a.opts.Mode == ModeFailover {
+		var errs []error
+		for _, src := range a.sources {
+			q, err := a.quote(ctx, src)
+			if err != nil {
+				log.Warn("rate provider failed", slog.String("provider", src.Provider.Name()), slog.String("error", err.Error()))
+				errs = append(errs, err)
+				continue
+			}
+			return q, nil
+		}
+		return nil, fmt.Errorf("%w: %w", utils.ErrorExchangeRateUnavailable, errors.Join(errs...))
+	}
+
+	results := collect(ctx, a.sources, a.quote)
+	quotes := make([]*Quote, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			log.Warn("rate provider failed", slog.String("provider", r.provider), slog.String("error", r.err.Error()))
+			continue
+		}
+		quotes = append(quotes, r.value)
+	}
+	if len(quotes) < a.opts.Quorum {
+		return nil, fmt.Errorf("%w: %d of %d rate providers responded", utils.ErrorExchangeRateUnavailable, len(quotes), a.opts.Quorum)
+	}
+	agreed := &Quote{Rates: make(map[string]float64), At: quotes[0].At}
+	values := make(map[string][]float64)
+	for _, q := range quotes {
+		if q.At.Before(agreed.At) {
+			agreed.At = q.At
+		}
+		for currency, v := range q.Rates {
+			values[currency] = append(values[currency], v)
+		}
+	}
+	for currency, vs := range values {
+		v, ok := a.consensus(vs)
+		if !ok {
+			log.Warn("rate providers disagree", slog.String("currency", currency), slog.Any("rates", vs))
+			continue
+		}
+		agreed.Rates[currency] = v
+	}
+	return agreed, nil
+}
+
+// Pair возвращает курс пары. В режиме консенсуса при расхождении провайдеров пара останавливается.
+func (a *Aggregator) Pair(ctx context.Context, from, to string) (float64, time.Time, error) {
+	const op = "Rate.Aggregator.Pair"
+	log := a.log.With(slog.String("op", op), slog.String("from", from), slog.String("to", to))
+
+	if a.opts.Mode == ModeFailover {
+		var errs []error
+		for _, src := range a.sources {
+			p, err := a.pair(ctx, src, from, to)
+			if err != nil {
+				log.Warn("rate provider failed", slog.String("provider", src.Provider.Name()), slog.String("error", err.Error()))
+				errs = append(errs, err)
+				continue
+			}
+			return p.rate, p.at, nil
+		}
+		return 0, time.Time{}, fmt.Errorf("%w: %w", utils.ErrorExchangeRateUnavailable, errors.Join(errs...))
+	}
+
+	results := collect(ctx, a.sources, func(ctx context.Context, src Source) (pairQuote, error) {
+		return a.pair(ctx, src, from, to)
+	})
+	values := make([]float64, 0, len(results))
+	byProvider := make(map[string]float64, len(results))
+	at := time.Time{}
+	for _, r := range results {
+		if r.err != nil {
+			log.Warn("rate provider failed", slog.String("provider", r.provider), slog.String("error", r.err.Error()))
+			continue
+		}
+		values = append(values, r.value.rate)
+		byProvider[r.provider] = r.value.rate
+		if at.IsZero() || r.value.at.Before(at) {
+			at = r.value.at
+		}
+	}
+	if len(values) < a.opts.Quorum {
+		return 0, time.Time{}, fmt.Errorf("%w: %d of %d rate providers responded", utils.ErrorExchangeRateUnavailable, len(values), a.opts.Quorum)
+	}
+	v, ok := a.consensus(values)
+	if !ok {
+		log.Warn("rate providers disagree, pair halted", slog.Any("rates", byProvider))
+		return 0, time.Time{}, utils.ErrorRatePairHalted
+	}
+	return v, at, nil
+}
+
+// quote запрашивает курсы у провайдера, отбрасывает устаревшие и приводит к базовой валюте.
+func (a *Aggregator) quote(ctx context.Context, src Source) (*Quote, error) {
+	q, err := src.Provider.Rates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := fresh(src, q.At); err != nil {
+		return nil, err
+	}
+	rebased, ok := rebase(q, a.opts.Base)
+	if !ok {
+		return nil, fmt.Errorf("no valid rate for base currency %s", a.opts.Base)
+	}
+	return rebased, nil
+}
+
+type pairQuote struct {
+	rate float64
+	at   time.Time
+}
+
+func (a *Aggregator) pair(ctx context.Context, src Source, from, to string) (pairQuote, error) {
+	v, at, err := src.Provider.Pair(ctx, from, to)
+	if err != nil {
+		return pairQuote{}, err
+	}
+	if !validRate(v) {
+		return pairQuote{}, fmt.Errorf("invalid rate %v", v)
+	}
+	if err := fresh(src, at); err != nil {
+		return pairQuote{}, err
+	}
+	return pairQuote{rate: v, at: at}, nil
+}
+
+// consensus отбрасывает значения, отклонившиеся от медианы больше чем на Tolerance,
+// и возвращает медиану оставшихся, если их не меньше Quorum.
+func (a *Aggregator) consensus(values []float64) (float64, bool) {
+	m := median(values)
+	agreeing := make([]float64, 0, len(values))
+	for _, v := range values {
+		if math.Abs(v-m)/m <= a.opts.Tolerance {
+			agreeing = append(agreeing, v)
+		}
+	}
+	if len(agreeing) < a.opts.Quorum {
+		return 0, false
+	}
+	return median(agreeing), true
+}
+
+func fresh(src Source, at time.Time) error {
+	if src.MaxAge > 0 && time.Since(at) > src.MaxAge {
+		return fmt.Errorf("rate is stale: captured at %s", at.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+type result[T any] struct {
+	provider string
+	value    T
+	err      error
+}
+
+// collect опрашивает все источники параллельно, порядок результатов совпадает с порядком источников.
+func collect[T any](ctx context.Context, sources []Source, fn func(ctx context.Context, src Source) (T, error)) []result[T] {
+	results := make([]result[T], len(sources))
+	var wg sync.WaitGroup
+	for i, src := range sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := fn(ctx, src)
+			results[i] = result[T]{provider: src.Provider.Name(), value: v, err: err}
+		}()
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,142 @@
+package rate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/fakeexchanger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// exchangerSource — провайдер поверх поддельного обменника в памяти процесса.
+func exchangerSource(t *testing.T, name string, rub float32, priority int) (Source, *fakeexchanger.Server) {
+	t.Helper()
+	srv := fakeexchanger.New(map[string]float32{"USD": 1, "RUB": rub})
+	dialOpts, stop := fakeexchanger.Bufconn(srv)
+	t.Cleanup(stop)
+	conn, err := grpc.NewClient(fakeexchanger.BufconnAddr, dialOpts...)
+	if err != nil {
+		t.Fatalf("dial %s: %v", name, err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return Source{Provider: NewGRPCProvider(name, walletsv1.NewExchangeServiceClient(conn)), Priority: priority}, srv
+}
+
+// staleSource — статический провайдер, курсы которого получены час назад.
+func staleSource(t *testing.T, rub float64, priority int) Source {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "rates.json")
+	doc := fmt.Sprintf(`{"base":"USD","timestamp":%q,"rates":{"RUB":%v}}`, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), rub)
+	if err := os.WriteFile(file, []byte(doc), 0o600); err != nil {
+		t.Fatalf("write rates: %v", err)
+	}
+	return Source{Provider: NewStaticProvider("stale", file, nil), Priority: priority, MaxAge: time.Minute}
+}
+
+// TestAggregatorPair — выбор курса пары: порядок опроса в failover, отбрасывание устаревших курсов и выбросов,
+// кворум и остановка пары при расхождении провайдеров.
+func TestAggregatorPair(t *testing.T) {
+	cases := []struct {
+		name string
+		opts AggregatorOptions
+		// sources собирает источники и возвращает проверку вызовов обменников
+		sources func(t *testing.T) ([]Source, func(t *testing.T))
+		want    float64
+		wantErr *utils.Error
+	}{
+		{
+			name: "failover skips failed provider in priority order",
+			opts: AggregatorOptions{Mode: ModeFailover, Base: "USD"},
+			sources: func(t *testing.T) ([]Source, func(t *testing.T)) {
+				third, thirdSrv := exchangerSource(t, "third", 92, 3)
+				second, _ := exchangerSource(t, "second", 91, 2)
+				first, firstSrv := exchangerSource(t, "first", 90, 1)
+				firstSrv.FailNext(1, codes.Unavailable)
+				return []Source{third, second, first}, func(t *testing.T) {
+					if got := firstSrv.Calls(); got != 1 {
+						t.Errorf("first provider calls = %d, want 1", got)
+					}
+					if got := thirdSrv.Calls(); got != 0 {
+						t.Errorf("third provider calls = %d, want 0", got)
+					}
+				}
+			},
+			want: 91,
+		},
+		{
+			name: "failover skips stale quote",
+			opts: AggregatorOptions{Mode: ModeFailover, Base: "USD"},
+			sources: func(t *testing.T) ([]Source, func(t *testing.T)) {
+				fresh, _ := exchangerSource(t, "fresh", 90, 2)
+				return []Source{staleSource(t, 80, 1), fresh}, nil
+			},
+			want: 90,
+		},
+		{
+			name: "consensus rejects outlier",
+			opts: AggregatorOptions{Mode: ModeConsensus, Base: "USD", Tolerance: 0.02, Quorum: 2},
+			sources: func(t *testing.T) ([]Source, func(t *testing.T)) {
+				a, _ := exchangerSource(t, "a", 90, 1)
+				b, _ := exchangerSource(t, "b", 91, 2)
+				c, _ := exchangerSource(t, "c", 120, 3)
+				return []Source{a, b, c}, nil
+			},
+			want: 90.5,
+		},
+		{
+			name: "consensus without quorum",
+			opts: AggregatorOptions{Mode: ModeConsensus, Base: "USD", Tolerance: 0.02, Quorum: 2},
+			sources: func(t *testing.T) ([]Source, func(t *testing.T)) {
+				a, _ := exchangerSource(t, "a", 90, 1)
+				b, bSrv := exchangerSource(t, "b", 90, 2)
+				bSrv.FailNext(1, codes.Unavailable)
+				return []Source{a, b, staleSource(t, 90, 3)}, nil
+			},
+			wantErr: utils.ErrorExchangeRateUnavailable,
+		},
+		{
+			name: "consensus halts pair when providers disagree",
+			opts: AggregatorOptions{Mode: ModeConsensus, Base: "USD", Tolerance: 0.02, Quorum: 2},
+			sources: func(t *testing.T) ([]Source, func(t *testing.T)) {
+				a, _ := exchangerSource(t, "a", 90, 1)
+				b, _ := exchangerSource(t, "b", 100, 2)
+				c, _ := exchangerSource(t, "c", 120, 3)
+				return []Source{a, b, c}, nil
+			},
+			wantErr: utils.ErrorRatePairHalted,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sources, check := c.sources(t)
+			agg, err := NewAggregator(sources, c.opts, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			if err != nil {
+				t.Fatalf("new aggregator: %v", err)
+			}
+			got, _, err := agg.Pair(context.Background(), "USD", "RUB")
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("err = %v, want %v", err, c.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("pair: %v", err)
+			} else if math.Abs(got-c.want) > 1e-4 {
+				t.Errorf("rate = %v, want %v", got, c.want)
+			}
+			if check != nil {
+				check(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package rate
+
+import (
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/google/uuid"
+)
+
+// PairRate — сохранённый курс пары: единица from_currency стоит rate единиц to_currency.
+type PairRate struct {
+	SnapshotID   uuid.UUID `json:"snapshot_id"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         float64   `json:"rate"`
+	CapturedAt   time.Time `json:"captured_at"`
+}
+
+type RateAtRequest struct {
+	From string `json:"from" validate:"required,currency"`
+	To   string `json:"to" validate:"required,currency,nefield=From"`
+	// At — момент времени в RFC 3339, по умолчанию текущий
+	At string `json:"at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type RateAtResponse struct {
+	api.Response
+	PairRate
+}
+
+// Интервалы свечей, которые принимает API.
+var CandleIntervals = map[string]time.Duration{
+	"1m":  time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"1h":  time.Hour,
+	"4h":  4 * time.Hour,
+	"1d":  24 * time.Hour,
+}
+
+const (
+	// defaultCandles — сколько свечей отдаётся, если не задано начало периода
+	defaultCandles = 100
+	maxCandles     = 1000
+)
+
+type CandlesRequest struct {
+	From     string `json:"from" validate:"required,currency"`
+	To       string `json:"to" validate:"required,currency,nefield=From"`
+	Interval string `json:"interval" validate:"required,oneof=1m 5m 15m 1h 4h 1d"`
+	Start    string `json:"start" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	End      string `json:"end" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// Candle — курс пары за интервал, начинающийся в Time. Samples — число сохранённых курсов в интервале.
+type Candle struct {
+	Time    time.Time `json:"time"`
+	Open    float64   `json:"open"`
+	High    float64   `json:"high"`
+	Low     float64   `json:"low"`
+	Close   float64   `json:"close"`
+	Samples int       `json:"samples"`
+}
+
+type CandlesResponse struct {
+	api.Response
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Interval string   `json:"interval"`
+	Candles  []Candle `json:"candles"`
+}
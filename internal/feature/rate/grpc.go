@@ -0,0 +1,46 @@
+package rate
+
+import (
+	"context"
+	"time"
+
+	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const GRPCProviderType = "grpc"
+
+// GRPCProvider отдаёт курсы сервиса обменника. Обменник не сообщает время курса,
+// поэтому курс считается полученным в момент ответа.
+type GRPCProvider struct {
+	name   string
+	client walletsv1.ExchangeServiceClient
+}
+
+func NewGRPCProvider(name string, client walletsv1.ExchangeServiceClient) *GRPCProvider {
+	return &GRPCProvider{name: name, client: client}
+}
+
+func (p *GRPCProvider) Name() string {
+	return p.name
+}
+
+func (p *GRPCProvider) Rates(ctx context.Context) (*Quote, error) {
+	data, err := p.client.GetExchangeRates(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	q := &Quote{Rates: make(map[string]float64, len(data.Rates)), At: time.Now().UTC()}
+	for currency, v := range data.Rates {
+		q.Rates[currency] = float64(v)
+	}
+	return q, nil
+}
+
+func (p *GRPCProvider) Pair(ctx context.Context, from, to string) (float64, time.Time, error) {
+	data, err := p.client.GetExchangeRateForCurrency(ctx, &walletsv1.CurrencyRequest{FromCurrency: from, ToCurrency: to})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return float64(data.Rate), time.Now().UTC(), nil
+}
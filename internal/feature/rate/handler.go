@@ -0,0 +1,127 @@
+package rate
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+)
+
+type HandlerRates interface {
+	RateAt(ctx context.Context, req RateAtRequest) (*PairRate, error)
+	Candles(ctx context.Context, req CandlesRequest) ([]Candle, error)
+}
+
+type Handler struct {
+	s   HandlerRates
+	log *slog.Logger
+}
+
+func NewHandler(s HandlerRates, log *slog.Logger) *Handler {
+	return &Handler{
+		s:   s,
+		log: log,
+	}
+}
+
+// @Summary RateAt
+// @ID getRateAt
+// @Tags rates
+// @Description stored exchange rate of the pair in effect at the given moment: the latest snapshot not after it
+// @Produce json
+// @Param from query string true "source currency" Enums(USD, EUR, RUB)
+// @Param to query string true "target currency" Enums(USD, EUR, RUB)
+// @Param at query string false "moment in RFC 3339, defaults to now"
+// @Success 200 {object}  RateAtResponse
+// @Failure 400,401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /exchange/rates/at [get]
+func (h *Handler) RateAtHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Rate.Handler.RateAt"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	query := r.URL.Query()
+	req := RateAtRequest{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+		At:   query.Get("at"),
+	}
+	if err := request.Validate(&req); err != nil {
+		api.WriteError(w, r, err)
+		return
+	}
+	rate, err := h.s.RateAt(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, RateAtResponse{
+		Response: api.OK(),
+		PairRate: *rate,
+	})
+}
+
+// @Summary RateCandles
+// @ID getRateCandles
+// @Tags rates
+// @Description OHLC candles of the pair built from stored rates. Without start the last 100 intervals before end
+// @Description are returned, at most 1000 intervals. Intervals without stored rates are omitted
+// @Produce json
+// @Param from query string true "source currency" Enums(USD, EUR, RUB)
+// @Param to query string true "target currency" Enums(USD, EUR, RUB)
+// @Param interval query string true "candle length" Enums(1m, 5m, 15m, 1h, 4h, 1d)
+// @Param start query string false "period start in RFC 3339"
+// @Param end query string false "period end in RFC 3339, defaults to now"
+// @Success 200 {object}  CandlesResponse
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /exchange/rates/candles [get]
+func (h *Handler) CandlesHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Rate.Handler.Candles"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	query := r.URL.Query()
+	req := CandlesRequest{
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+		Interval: query.Get("interval"),
+		Start:    query.Get("start"),
+		End:      query.Get("end"),
+	}
+	if err := request.Validate(&req); err != nil {
+		api.WriteError(w, r, err)
+		return
+	}
+	candles, err := h.s.Candles(r.Context(), req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, CandlesResponse{
+		Response: api.OK(),
+		From:     req.From,
+		To:       req.To,
+		Interval: req.Interval,
+		Candles:  candles,
+	})
+}
+
+// writeError отвечает доменной ошибкой, в лог пишутся только непредвиденные ошибки.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	if utils.AsError(err).Kind == utils.KindInternal {
+		log.Error("request failed", logger.Err(err))
+	}
+	api.WriteError(w, r, err)
+}
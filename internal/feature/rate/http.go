@@ -0,0 +1,60 @@
+package rate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const HTTPProviderType = "http"
+
+// maxQuoteBody ограничивает ответ HTTP-провайдера, курсы нескольких валют занимают сотни байт.
+const maxQuoteBody = 1 << 20
+
+// HTTPProvider забирает курсы GET-запросом к url. Ответ — JSON вида
+// {"base": "USD", "timestamp": "2025-07-01T10:00:00Z", "rates": {"EUR": 0.92, "RUB": 90.1}}.
+type HTTPProvider struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+func NewHTTPProvider(name, url string, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		name:   name,
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *HTTPProvider) Name() string {
+	return p.name
+}
+
+func (p *HTTPProvider) Rates(ctx context.Context) (*Quote, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rate provider responded %s", resp.Status)
+	}
+	var doc quoteDocument
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxQuoteBody)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode rates: %w", err)
+	}
+	return doc.quote(time.Now().UTC())
+}
+
+func (p *HTTPProvider) Pair(ctx context.Context, from, to string) (float64, time.Time, error) {
+	return pairFromRates(ctx, p, from, to)
+}
@@ -0,0 +1,56 @@
+package rate
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Quote — курсы всех валют относительно общей базы: единица базы стоит Rates[c] единиц валюты c.
+// At — момент, на который провайдер отдал курсы.
+type Quote struct {
+	Rates map[string]float64
+	At    time.Time
+}
+
+// RateProvider — источник курсов. Провайдеры не знают друг о друге,
+// выбор и сверка значений делаются в Aggregator.
+type RateProvider interface {
+	Name() string
+	Rates(ctx context.Context) (*Quote, error)
+	// Pair возвращает курс пары: единица from стоит rate единиц to.
+	Pair(ctx context.Context, from, to string) (rate float64, at time.Time, err error)
+}
+
+func validRate(v float64) bool {
+	return v > 0 && !math.IsInf(v, 0) && !math.IsNaN(v)
+}
+
+// pairFromQuote считает курс пары по курсам относительно общей базы.
+func pairFromQuote(q *Quote, from, to string) (float64, bool) {
+	fromRate, ok := q.Rates[from]
+	if !ok || !validRate(fromRate) {
+		return 0, false
+	}
+	toRate, ok := q.Rates[to]
+	if !ok || !validRate(toRate) {
+		return 0, false
+	}
+	return toRate / fromRate, true
+}
+
+// rebase пересчитывает курсы относительно base. Провайдеры могут отдавать курсы к разным базам,
+// сравнивать их можно только после приведения к одной.
+func rebase(q *Quote, base string) (*Quote, bool) {
+	baseRate, ok := q.Rates[base]
+	if !ok || !validRate(baseRate) {
+		return nil, false
+	}
+	rates := make(map[string]float64, len(q.Rates))
+	for currency, v := range q.Rates {
+		if validRate(v) {
+			rates[currency] = v / baseRate
+		}
+	}
+	return &Quote{Rates: rates, At: q.At}, true
+}
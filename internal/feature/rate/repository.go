@@ -0,0 +1,124 @@
+package rate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+// EnsurePartition создаёт секцию rate_history за месяц, в который попадает month.
+func (r *Repository) EnsurePartition(ctx context.Context, month time.Time) error {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	// в DDL нельзя передать параметры, имя и границы собираются только из даты
+	query := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS rate_history_%s PARTITION OF rate_history FOR VALUES FROM ('%s') TO ('%s')",
+		start.Format("200601"), start.Format(time.DateOnly), end.Format(time.DateOnly),
+	)
+	_, err := db.Conn(ctx, r.primaryDB).Exec(ctx, query)
+	return err
+}
+
+// SaveRates записывает курсы одного снимка.
+func (r *Repository) SaveRates(ctx context.Context, rates []PairRate) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.Insert("rate_history").
+		Columns("snapshot_id", "from_currency", "to_currency", "rate", "captured_at").
+		PlaceholderFormat(sq.Dollar)
+	for _, p := range rates {
+		builder = builder.Values(p.SnapshotID, p.FromCurrency, p.ToCurrency, p.Rate, p.CapturedAt)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, args...)
+	return err
+}
+
+// RateAt возвращает последний сохранённый курс пары не позже at.
+func (r *Repository) RateAt(ctx context.Context, from, to string, at time.Time) (*PairRate, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.
+		Select("snapshot_id", "from_currency", "to_currency", "rate", "captured_at").
+		From("rate_history").
+		Where(sq.Eq{"from_currency": from, "to_currency": to}).
+		Where(sq.LtOrEq{"captured_at": at}).
+		OrderBy("captured_at DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	var p PairRate
+	err = conn.QueryRow(ctx, query, args...).Scan(&p.SnapshotID, &p.FromCurrency, &p.ToCurrency, &p.Rate, &p.CapturedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorRateSnapshotNotFound
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Candles группирует курсы пары в интервалы длиной interval на полуинтервале [start, end).
+// Интервалы без сохранённых курсов не возвращаются.
+func (r *Repository) Candles(ctx context.Context, from, to string, interval time.Duration, start, end time.Time) ([]Candle, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.
+		Select(
+			"bucket",
+			"(array_agg(rate ORDER BY captured_at))[1]",
+			"MAX(rate)",
+			"MIN(rate)",
+			"(array_agg(rate ORDER BY captured_at DESC))[1]",
+			"COUNT(*)",
+		).
+		FromSelect(sq.
+			Select("rate", "captured_at").
+			Column(sq.Expr("date_bin(?::INTERVAL, captured_at, TIMESTAMP '2000-01-01') AS bucket", interval)).
+			From("rate_history").
+			Where(sq.Eq{"from_currency": from, "to_currency": to}).
+			Where(sq.GtOrEq{"captured_at": start}).
+			Where(sq.Lt{"captured_at": end}), "h").
+		GroupBy("bucket").
+		OrderBy("bucket").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	candles := make([]Candle, 0)
+	for rows.Next() {
+		var c Candle
+		if err := rows.Scan(&c.Time, &c.Open, &c.High, &c.Low, &c.Close, &c.Samples); err != nil {
+			return nil, err
+		}
+		candles = append(candles, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return candles, nil
+}
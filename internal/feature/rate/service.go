@@ -0,0 +1,180 @@
+package rate
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type ServiceRates interface {
+	EnsurePartition(ctx context.Context, month time.Time) error
+	SaveRates(ctx context.Context, rates []PairRate) error
+	RateAt(ctx context.Context, from, to string, at time.Time) (*PairRate, error)
+	Candles(ctx context.Context, from, to string, interval time.Duration, start, end time.Time) ([]Candle, error)
+}
+
+type Service struct {
+	repository ServiceRates
+	exchanger  RateProvider
+	tx         *db.TxManager
+	// partitions — месяцы, секции которых уже созданы этим экземпляром
+	partitions sync.Map
+	log        *slog.Logger
+}
+
+func NewService(r ServiceRates, exchanger RateProvider, tx *db.TxManager, log *slog.Logger) *Service {
+	return &Service{
+		repository: r,
+		exchanger:  exchanger,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+// RecordPair сохраняет курс пары, полученный от обменника, и возвращает снимок, на который может сослаться операция обмена.
+func (s *Service) RecordPair(ctx context.Context, from, to string, rate float32, at time.Time) (uuid.UUID, error) {
+	id := uuid.New()
+	err := s.save(ctx, []PairRate{{
+		SnapshotID:   id,
+		FromCurrency: from,
+		ToCurrency:   to,
+		Rate:         float64(rate),
+		CapturedAt:   at,
+	}})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// RecordAll сохраняет одним снимком курсы всех пар.
+func (s *Service) RecordAll(ctx context.Context) (uuid.UUID, error) {
+	quote, err := s.exchanger.Rates(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	id := uuid.New()
+	pairs := make([]PairRate, 0, len(quote.Rates)*len(quote.Rates))
+	for from := range quote.Rates {
+		for to := range quote.Rates {
+			if from == to {
+				continue
+			}
+			v, ok := pairFromQuote(quote, from, to)
+			if !ok {
+				continue
+			}
+			pairs = append(pairs, PairRate{
+				SnapshotID:   id,
+				FromCurrency: from,
+				ToCurrency:   to,
+				Rate:         v,
+				CapturedAt:   quote.At,
+			})
+		}
+	}
+	if len(pairs) == 0 {
+		return uuid.Nil, utils.ErrorExchangeRateUnavailable
+	}
+	if err := s.save(ctx, pairs); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// StartRecorder по тикеру сохраняет курсы всех пар и заранее создаёт секцию на следующий месяц.
+func (s *Service) StartRecorder(ctx context.Context, handlePeriod time.Duration) {
+	const op = "Rate.Service.StartRecorder"
+
+	log := s.log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping rate recorder")
+				return
+
+			case <-ticker.C:
+				if err := s.ensurePartition(ctx, time.Now().UTC().AddDate(0, 1, 0)); err != nil {
+					log.Error("failed to create next rate history partition", logger.Err(err))
+				}
+				if _, err := s.RecordAll(ctx); err != nil {
+					log.Error("failed to record rates", logger.Err(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *Service) RateAt(ctx context.Context, req RateAtRequest) (*PairRate, error) {
+	at := time.Now().UTC()
+	if req.At != "" {
+		parsed, err := time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			return nil, utils.ErrorRateRangeInvalid
+		}
+		at = parsed.UTC()
+	}
+	return s.repository.RateAt(ctx, req.From, req.To, at)
+}
+
+// Candles строит свечи пары. Без начала периода отдаются последние 100 интервалов до end,
+// период больше 1000 интервалов отклоняется.
+func (s *Service) Candles(ctx context.Context, req CandlesRequest) ([]Candle, error) {
+	interval, ok := CandleIntervals[req.Interval]
+	if !ok {
+		return nil, utils.ErrorRateRangeInvalid
+	}
+	end := time.Now().UTC()
+	if req.End != "" {
+		parsed, err := time.Parse(time.RFC3339, req.End)
+		if err != nil {
+			return nil, utils.ErrorRateRangeInvalid
+		}
+		end = parsed.UTC()
+	}
+	start := end.Add(-defaultCandles * interval)
+	if req.Start != "" {
+		parsed, err := time.Parse(time.RFC3339, req.Start)
+		if err != nil {
+			return nil, utils.ErrorRateRangeInvalid
+		}
+		start = parsed.UTC()
+	}
+	if !end.After(start) || end.Sub(start) > maxCandles*interval {
+		return nil, utils.ErrorRateRangeInvalid
+	}
+	return s.repository.Candles(ctx, req.From, req.To, interval, start, end)
+}
+
+func (s *Service) save(ctx context.Context, rates []PairRate) error {
+	if err := s.ensurePartition(ctx, rates[0].CapturedAt); err != nil {
+		return err
+	}
+	return s.tx.Do(ctx, func(ctx context.Context) error {
+		return s.repository.SaveRates(ctx, rates)
+	})
+}
+
+// ensurePartition создаёт секцию месяца один раз за время жизни экземпляра.
+func (s *Service) ensurePartition(ctx context.Context, at time.Time) error {
+	month := at.Format("200601")
+	if _, ok := s.partitions.Load(month); ok {
+		return nil
+	}
+	if err := s.repository.EnsurePartition(ctx, at); err != nil {
+		return err
+	}
+	s.partitions.Store(month, struct{}{})
+	return nil
+}
@@ -0,0 +1,88 @@
+package rate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+const StaticProviderType = "static"
+
+// quoteDocument — формат курсов в файле статического провайдера и в ответе HTTP-провайдера.
+// Курс базы можно не указывать, он равен 1. Без timestamp курс считается актуальным на момент чтения.
+type quoteDocument struct {
+	Base      string             `json:"base"`
+	Timestamp *time.Time         `json:"timestamp"`
+	Rates     map[string]float64 `json:"rates"`
+}
+
+func (d quoteDocument) quote(now time.Time) (*Quote, error) {
+	if len(d.Rates) == 0 {
+		return nil, fmt.Errorf("no rates in document")
+	}
+	q := &Quote{Rates: make(map[string]float64, len(d.Rates)+1), At: now}
+	for currency, v := range d.Rates {
+		q.Rates[currency] = v
+	}
+	if d.Base != "" {
+		if _, ok := q.Rates[d.Base]; !ok {
+			q.Rates[d.Base] = 1
+		}
+	}
+	if d.Timestamp != nil {
+		q.At = d.Timestamp.UTC()
+	}
+	return q, nil
+}
+
+// StaticProvider отдаёт курсы из конфига или из JSON-файла. Файл перечитывается на каждый запрос,
+// поэтому курсы можно поменять без перезапуска. Подходит как запасной источник и как опорное
+// значение для сверки в режиме консенсуса.
+type StaticProvider struct {
+	name  string
+	file  string
+	rates map[string]float64
+}
+
+func NewStaticProvider(name, file string, rates map[string]float64) *StaticProvider {
+	return &StaticProvider{name: name, file: file, rates: rates}
+}
+
+func (p *StaticProvider) Name() string {
+	return p.name
+}
+
+func (p *StaticProvider) Rates(_ context.Context) (*Quote, error) {
+	now := time.Now().UTC()
+	if p.file == "" {
+		return quoteDocument{Rates: p.rates}.quote(now)
+	}
+	data, err := os.ReadFile(p.file)
+	if err != nil {
+		return nil, err
+	}
+	var doc quoteDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", p.file, err)
+	}
+	return doc.quote(now)
+}
+
+func (p *StaticProvider) Pair(ctx context.Context, from, to string) (float64, time.Time, error) {
+	return pairFromRates(ctx, p, from, to)
+}
+
+// pairFromRates считает курс пары по всем курсам провайдера, у которого нет отдельного запроса пары.
+func pairFromRates(ctx context.Context, p RateProvider, from, to string) (float64, time.Time, error) {
+	q, err := p.Rates(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	v, ok := pairFromQuote(q, from, to)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("no rate for %s/%s", from, to)
+	}
+	return v, q.At, nil
+}
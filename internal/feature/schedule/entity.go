@@ -0,0 +1,199 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	KindTransfer Kind = "TRANSFER"
+	KindExchange Kind = "EXCHANGE"
+)
+
+type Frequency string
+
+const (
+	FrequencyOnce    Frequency = "ONCE"
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+type Status string
+
+const (
+	StatusActive    Status = "ACTIVE"
+	StatusPaused    Status = "PAUSED"
+	StatusCompleted Status = "COMPLETED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+type RunResult string
+
+const (
+	RunExecuted RunResult = "EXECUTED"
+	RunSkipped  RunResult = "SKIPPED"
+	RunFailed   RunResult = "FAILED"
+)
+
+// Типы событий outbox по результату выполнения и коды уведомлений в каталоге i18n.
+const (
+	EventTypeExecuted = "SCHEDULE_EXECUTED"
+	EventTypeSkipped  = "SCHEDULE_SKIPPED"
+	EventTypeFailed   = "SCHEDULE_FAILED"
+
+	NotificationExecuted = "notification.schedule_executed"
+	NotificationSkipped  = "notification.schedule_skipped"
+	NotificationFailed   = "notification.schedule_failed"
+)
+
+// At возвращает время n-го (с нуля) запуска. Для ежемесячных расписаний день
+// прижимается к концу месяца: старт 31 января даёт 28(29) февраля и 31 марта.
+func (f Frequency) At(start time.Time, n int) time.Time {
+	switch f {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, n)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case FrequencyMonthly:
+		y, m, d := start.Date()
+		first := time.Date(y, m+time.Month(n), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+		last := first.AddDate(0, 1, -1).Day()
+		return first.AddDate(0, 0, min(d, last)-1)
+	default:
+		return start
+	}
+}
+
+type ScheduleDB struct {
+	ID             uuid.UUID  `db:"id"`
+	UserID         uuid.UUID  `db:"user_id"`
+	Kind           Kind       `db:"kind"`
+	Amount         float32    `db:"amount"`
+	Currency       string     `db:"currency"`
+	ToCurrency     *string    `db:"to_currency"`
+	RecipientID    *uuid.UUID `db:"recipient_id"`
+	RecipientEmail *string    `db:"recipient_email"`
+	Frequency      Frequency  `db:"frequency"`
+	StartAt        time.Time  `db:"start_at"`
+	EndAt          *time.Time `db:"end_at"`
+	NextRunAt      *time.Time `db:"next_run_at"`
+	Occurrence     int        `db:"occurrence"`
+	Status         Status     `db:"status"`
+	LastRunAt      *time.Time `db:"last_run_at"`
+	LastResult     *RunResult `db:"last_result"`
+	Version        int64      `db:"version"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// next возвращает время запуска с номером occurrence. nil означает, что расписание исчерпано.
+func (s *ScheduleDB) next(occurrence int) *time.Time {
+	if s.Frequency == FrequencyOnce {
+		return nil
+	}
+	at := s.Frequency.At(s.StartAt, occurrence)
+	if s.EndAt != nil && at.After(*s.EndAt) {
+		return nil
+	}
+	return &at
+}
+
+type CreateScheduleRequest struct {
+	Kind           Kind       `json:"kind" validate:"required,oneof=TRANSFER EXCHANGE"`
+	Amount         float32    `json:"amount" validate:"required,money"`
+	Currency       string     `json:"currency" validate:"required,currency"`
+	ToCurrency     string     `json:"to_currency,omitempty" validate:"required_if=Kind EXCHANGE,excluded_unless=Kind EXCHANGE,omitempty,currency,nefield=Currency"`
+	RecipientEmail string     `json:"recipient_email,omitempty" validate:"required_if=Kind TRANSFER,excluded_unless=Kind TRANSFER,omitempty,email"`
+	Frequency      Frequency  `json:"frequency" validate:"required,oneof=ONCE DAILY WEEKLY MONTHLY"`
+	StartAt        time.Time  `json:"start_at" validate:"required"`
+	EndAt          *time.Time `json:"end_at,omitempty" validate:"omitempty,gtfield=StartAt"`
+	TOTPCode       string     `json:"totp_code,omitempty"`
+}
+
+type UpdateScheduleRequest struct {
+	Version  int64      `json:"version" validate:"required,min=1"`
+	Amount   *float32   `json:"amount,omitempty" validate:"omitempty,money"`
+	EndAt    *time.Time `json:"end_at,omitempty"`
+	Status   *Status    `json:"status,omitempty" validate:"omitempty,oneof=ACTIVE PAUSED"`
+	TOTPCode string     `json:"totp_code,omitempty"`
+}
+
+type Schedule struct {
+	ID             uuid.UUID  `json:"id"`
+	Kind           Kind       `json:"kind"`
+	Amount         float32    `json:"amount"`
+	Currency       string     `json:"currency"`
+	ToCurrency     *string    `json:"to_currency,omitempty"`
+	RecipientEmail *string    `json:"recipient_email,omitempty"`
+	Frequency      Frequency  `json:"frequency"`
+	StartAt        time.Time  `json:"start_at"`
+	EndAt          *time.Time `json:"end_at,omitempty"`
+	NextRunAt      *time.Time `json:"next_run_at,omitempty"`
+	Status         Status     `json:"status"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	LastResult     *RunResult `json:"last_result,omitempty"`
+	Version        int64      `json:"version"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func NewSchedule(s *ScheduleDB) Schedule {
+	return Schedule{
+		ID:             s.ID,
+		Kind:           s.Kind,
+		Amount:         s.Amount,
+		Currency:       s.Currency,
+		ToCurrency:     s.ToCurrency,
+		RecipientEmail: s.RecipientEmail,
+		Frequency:      s.Frequency,
+		StartAt:        s.StartAt,
+		EndAt:          s.EndAt,
+		NextRunAt:      s.NextRunAt,
+		Status:         s.Status,
+		LastRunAt:      s.LastRunAt,
+		LastResult:     s.LastResult,
+		Version:        s.Version,
+		CreatedAt:      s.CreatedAt,
+	}
+}
+
+type ScheduleResponse struct {
+	api.Response
+	Schedule Schedule `json:"schedule"`
+}
+
+type SchedulesResponse struct {
+	api.Response
+	Schedules []Schedule `json:"schedules"`
+}
+
+// RunDB — запись о выполнении одного запуска, первичный ключ (schedule_id, occurrence)
+// не даёт обработать запуск дважды.
+type RunDB struct {
+	ScheduleID  uuid.UUID `db:"schedule_id"`
+	Occurrence  int       `db:"occurrence"`
+	ScheduledAt time.Time `db:"scheduled_at"`
+	Result      RunResult `db:"result"`
+	ErrorCode   *string   `db:"error_code"`
+}
+
+type KafkaPayloadSchedule struct {
+	ScheduleID  uuid.UUID  `json:"schedule_id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Kind        Kind       `json:"kind"`
+	Occurrence  int        `json:"occurrence"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	Result      RunResult  `json:"result"`
+	Amount      float32    `json:"amount"`
+	Currency    string     `json:"currency"`
+	ToCurrency  *string    `json:"to_currency,omitempty"`
+	RecipientID *uuid.UUID `json:"recipient_id,omitempty"`
+	ErrorCode   string     `json:"error_code,omitempty"`
+	Code        string     `json:"code"`
+	Locale      string     `json:"locale"`
+	Message     string     `json:"message"`
+}
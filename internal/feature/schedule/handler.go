@@ -0,0 +1,247 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	httphandlers "github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+)
+
+type HandlerSchedules interface {
+	CreateSchedule(ctx context.Context, userID uuid.UUID, req CreateScheduleRequest) (*ScheduleDB, error)
+	GetSchedule(ctx context.Context, userID, id uuid.UUID) (*ScheduleDB, error)
+	ListSchedules(ctx context.Context, userID uuid.UUID) ([]*ScheduleDB, error)
+	UpdateSchedule(ctx context.Context, userID, id uuid.UUID, req UpdateScheduleRequest) (*ScheduleDB, error)
+	CancelSchedule(ctx context.Context, userID, id uuid.UUID) error
+}
+
+type Handler struct {
+	s   HandlerSchedules
+	log *slog.Logger
+}
+
+func NewHandler(s HandlerSchedules, log *slog.Logger) *Handler {
+	return &Handler{
+		s:   s,
+		log: log,
+	}
+}
+
+// writeError отвечает доменной ошибкой, в лог пишутся только непредвиденные ошибки.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	if utils.AsError(err).Kind == utils.KindInternal {
+		log.Error("request failed", logger.Err(err))
+	}
+	api.WriteError(w, r, err)
+}
+
+// @Summary CreateSchedule
+// @ID createSchedule
+// @Tags schedules
+// @Description create a standing order: a transfer to another user by email or an exchange at the market rate,
+// @Description once or every day, week or month starting at start_at. Large amounts require totp_code
+// @Accept json
+// @Produce json
+// @Param input body CreateScheduleRequest true "schedule"
+// @Success 201 {object}  ScheduleResponse
+// @Failure 400,401,403,404,413 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /schedules [post]
+func (h *Handler) CreateScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Schedule.Handler.CreateSchedule"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req CreateScheduleRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	schedule, err := h.s.CreateSchedule(r.Context(), claims.ID, req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, ScheduleResponse{
+		Response: api.OK(),
+		Schedule: NewSchedule(schedule),
+	})
+}
+
+// @Summary ListSchedules
+// @ID listSchedules
+// @Tags schedules
+// @Description standing orders of the current user, cancelled ones are not listed
+// @Produce json
+// @Success 200 {object}  SchedulesResponse
+// @Failure 401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /schedules [get]
+func (h *Handler) ListSchedulesHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Schedule.Handler.ListSchedules"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	schedules, err := h.s.ListSchedules(r.Context(), claims.ID)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	resp := SchedulesResponse{
+		Response:  api.OK(),
+		Schedules: make([]Schedule, 0, len(schedules)),
+	}
+	for _, s := range schedules {
+		resp.Schedules = append(resp.Schedules, NewSchedule(s))
+	}
+	render.JSON(w, r, resp)
+}
+
+// @Summary GetSchedule
+// @ID getSchedule
+// @Tags schedules
+// @Description standing order with its next run and last result
+// @Produce json
+// @Param id path string true "schedule id"
+// @Success 200 {object}  ScheduleResponse
+// @Failure 400,401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /schedules/{id} [get]
+func (h *Handler) GetScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Schedule.Handler.GetSchedule"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidScheduleID)
+		return
+	}
+	schedule, err := h.s.GetSchedule(r.Context(), claims.ID, id)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, ScheduleResponse{
+		Response: api.OK(),
+		Schedule: NewSchedule(schedule),
+	})
+}
+
+// @Summary UpdateSchedule
+// @ID updateSchedule
+// @Tags schedules
+// @Description change amount or end date, pause (PAUSED) or resume (ACTIVE) a standing order.
+// @Description Runs missed while paused are not executed. version must match the current schedule version
+// @Accept json
+// @Produce json
+// @Param id path string true "schedule id"
+// @Param input body UpdateScheduleRequest true "schedule changes"
+// @Success 200 {object}  ScheduleResponse
+// @Failure 400,401,403,404,409,413 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /schedules/{id} [patch]
+func (h *Handler) UpdateScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Schedule.Handler.UpdateSchedule"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidScheduleID)
+		return
+	}
+	var req UpdateScheduleRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	schedule, err := h.s.UpdateSchedule(r.Context(), claims.ID, id, req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, ScheduleResponse{
+		Response: api.OK(),
+		Schedule: NewSchedule(schedule),
+	})
+}
+
+// @Summary CancelSchedule
+// @ID cancelSchedule
+// @Tags schedules
+// @Description cancel a standing order, runs already executed are not reverted
+// @Produce json
+// @Param id path string true "schedule id"
+// @Success 200 {object}  api.Response
+// @Failure 400,401,404,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /schedules/{id} [delete]
+func (h *Handler) CancelScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Schedule.Handler.CancelSchedule"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidScheduleID)
+		return
+	}
+	if err := h.s.CancelSchedule(r.Context(), claims.ID, id); err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
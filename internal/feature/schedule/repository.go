@@ -0,0 +1,265 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+const scheduleColumns = `s.id, s.user_id, s.kind, s.amount, s.currency, s.to_currency, s.recipient_id, u.email,
+	s.frequency, s.start_at, s.end_at, s.next_run_at, s.occurrence, s.status, s.last_run_at, s.last_result,
+	s.version, s.created_at, s.updated_at`
+
+func scanSchedule(row pgx.Row) (*ScheduleDB, error) {
+	var s ScheduleDB
+	if err := row.Scan(&s.ID, &s.UserID, &s.Kind, &s.Amount, &s.Currency, &s.ToCurrency, &s.RecipientID, &s.RecipientEmail,
+		&s.Frequency, &s.StartAt, &s.EndAt, &s.NextRunAt, &s.Occurrence, &s.Status, &s.LastRunAt, &s.LastResult,
+		&s.Version, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func selectSchedules() sq.SelectBuilder {
+	return sq.Select(scheduleColumns).
+		From("schedules s").
+		LeftJoin("users u ON u.id = s.recipient_id").
+		PlaceholderFormat(sq.Dollar)
+}
+
+func (r *Repository) CreateSchedule(ctx context.Context, s *ScheduleDB) (uuid.UUID, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Insert("schedules").
+		Columns("user_id", "kind", "amount", "currency", "to_currency", "recipient_id",
+			"frequency", "start_at", "end_at", "next_run_at").
+		Values(s.UserID, s.Kind, s.Amount, s.Currency, s.ToCurrency, s.RecipientID,
+			s.Frequency, s.StartAt, s.EndAt, s.NextRunAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, utils.ErrorQueryString
+	}
+	var id uuid.UUID
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&id); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (r *Repository) GetSchedule(ctx context.Context, id, userID uuid.UUID) (*ScheduleDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := selectSchedules().
+		Where(sq.Eq{"s.id": id, "s.user_id": userID}).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	s, err := scanSchedule(conn.QueryRow(ctx, query, arg...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorScheduleNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListSchedules возвращает расписания пользователя, кроме отменённых.
+func (r *Repository) ListSchedules(ctx context.Context, userID uuid.UUID) ([]*ScheduleDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := selectSchedules().
+		Where(sq.Eq{"s.user_id": userID}).
+		Where(sq.NotEq{"s.status": StatusCancelled}).
+		OrderBy("s.created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	schedules := make([]*ScheduleDB, 0)
+	for rows.Next() {
+		s, err := scanSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// UpdateSchedule сохраняет изменения пользователя, если версия совпадает и расписание ещё не закрыто.
+func (r *Repository) UpdateSchedule(ctx context.Context, s *ScheduleDB, version int64) error {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Update("schedules").
+		Set("amount", s.Amount).
+		Set("end_at", s.EndAt).
+		Set("status", s.Status).
+		Set("next_run_at", s.NextRunAt).
+		Set("occurrence", s.Occurrence).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": s.ID, "user_id": s.UserID, "version": version}).
+		Where(sq.Eq{"status": []Status{StatusActive, StatusPaused}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorVersionConflict
+	}
+	return nil
+}
+
+func (r *Repository) CancelSchedule(ctx context.Context, id, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Update("schedules").
+		Set("status", StatusCancelled).
+		Set("next_run_at", nil).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": id, "user_id": userID}).
+		Where(sq.Eq{"status": []Status{StatusActive, StatusPaused}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorScheduleClosed
+	}
+	return nil
+}
+
+// ClaimDueSchedules забирает наступившие запуски и блокирует их на lease,
+// чтобы несколько экземпляров сервиса не выполняли одно расписание одновременно.
+// Если воркер упал, запуск снова станет доступен после истечения lease.
+func (r *Repository) ClaimDueSchedules(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*ScheduleDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query := `
+		WITH due AS (
+			SELECT id FROM schedules
+			WHERE status = 'ACTIVE'
+			  AND next_run_at <= $1
+			  AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY next_run_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		), claimed AS (
+			UPDATE schedules s SET locked_until = $2
+			FROM due
+			WHERE s.id = due.id
+			RETURNING s.*
+		)
+		SELECT ` + scheduleColumns + `
+		FROM claimed s
+		LEFT JOIN users u ON u.id = s.recipient_id
+		ORDER BY s.next_run_at;
+	`
+	rows, err := conn.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	schedules := make([]*ScheduleDB, 0)
+	for rows.Next() {
+		s, err := scanSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// CreateRun записывает результат запуска. false означает, что запуск уже был обработан.
+func (r *Repository) CreateRun(ctx context.Context, run *RunDB) (bool, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Insert("schedule_runs").
+		Columns("schedule_id", "occurrence", "scheduled_at", "result", "error_code").
+		Values(run.ScheduleID, run.Occurrence, run.ScheduledAt, run.Result, run.ErrorCode).
+		Suffix("ON CONFLICT (schedule_id, occurrence) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// AdvanceSchedule переводит расписание к следующему запуску и снимает блокировку.
+// Пустой nextRunAt завершает расписание. Условие на occurrence не даёт сдвинуть расписание дважды.
+func (r *Repository) AdvanceSchedule(ctx context.Context, run *RunDB, nextRunAt *time.Time) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	status := sq.Expr("status")
+	if nextRunAt == nil {
+		status = sq.Expr("?", StatusCompleted)
+	}
+	query, arg, err := sq.
+		Update("schedules").
+		Set("occurrence", run.Occurrence+1).
+		Set("next_run_at", nextRunAt).
+		Set("status", status).
+		Set("last_run_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("last_result", run.Result).
+		Set("locked_until", nil).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": run.ScheduleID, "occurrence": run.Occurrence}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type ServiceSchedules interface {
+	CreateSchedule(ctx context.Context, s *ScheduleDB) (uuid.UUID, error)
+	GetSchedule(ctx context.Context, id, userID uuid.UUID) (*ScheduleDB, error)
+	ListSchedules(ctx context.Context, userID uuid.UUID) ([]*ScheduleDB, error)
+	UpdateSchedule(ctx context.Context, s *ScheduleDB, version int64) error
+	CancelSchedule(ctx context.Context, id, userID uuid.UUID) error
+	ClaimDueSchedules(ctx context.Context, now time.Time, lease time.Duration, limit uint64) ([]*ScheduleDB, error)
+	CreateRun(ctx context.Context, run *RunDB) (bool, error)
+	AdvanceSchedule(ctx context.Context, run *RunDB, nextRunAt *time.Time) error
+}
+
+type ServiceWallet interface {
+	Transfer(ctx context.Context, fromUserID, toUserID uuid.UUID, currency string, amount float32, idempotencyKey string) (*models.CurrencyWallet, error)
+	ExchangeAtMarket(ctx context.Context, userid uuid.UUID, from_currency, to_currency string, amount float32, idempotencyKey string) (*models.CurrencyWallet, error)
+}
+
+type ServiceUsers interface {
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	RequireStepUp(ctx context.Context, userID uuid.UUID, amount float32, currency, code string) error
+	RecipientID(ctx context.Context, email string) (uuid.UUID, error)
+	NotificationLocale(ctx context.Context, userID uuid.UUID) (i18n.Locale, error)
+}
+
+type ServiceEvents interface {
+	CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error)
+}
+
+type Service struct {
+	repository ServiceSchedules
+	wallet     ServiceWallet
+	users      ServiceUsers
+	events     ServiceEvents
+	tx         *db.TxManager
+	log        *slog.Logger
+}
+
+func NewService(r ServiceSchedules, wallet ServiceWallet, users ServiceUsers, events ServiceEvents, tx *db.TxManager, log *slog.Logger) *Service {
+	return &Service{
+		repository: r,
+		wallet:     wallet,
+		users:      users,
+		events:     events,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+// startSkew — допустимое отставание start_at от текущего времени, чтобы «сейчас» с клиента не отклонялось.
+const startSkew = time.Minute
+
+// CreateSchedule проверяет те же условия, что и разовое списание: подтверждённый email
+// и второй фактор для крупных сумм. Получатель перевода ищется по email.
+func (s *Service) CreateSchedule(ctx context.Context, userID uuid.UUID, req CreateScheduleRequest) (*ScheduleDB, error) {
+	const op = "Schedule.Service.CreateSchedule"
+	log := s.log.With(slog.String("op", op))
+
+	startAt := req.StartAt.UTC()
+	if startAt.Before(time.Now().UTC().Add(-startSkew)) {
+		return nil, utils.ErrorScheduleStartInPast
+	}
+	verified, err := s.users.IsEmailVerified(ctx, userID)
+	if err != nil {
+		log.Error("failed to check email verification", logger.Err(err))
+		return nil, err
+	}
+	if !verified {
+		return nil, utils.ErrorEmailNotVerified
+	}
+	if err := s.users.RequireStepUp(ctx, userID, req.Amount, req.Currency, req.TOTPCode); err != nil {
+		return nil, err
+	}
+
+	schedule := &ScheduleDB{
+		UserID:    userID,
+		Kind:      req.Kind,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Frequency: req.Frequency,
+		StartAt:   startAt,
+		NextRunAt: &startAt,
+	}
+	if req.EndAt != nil {
+		endAt := req.EndAt.UTC()
+		schedule.EndAt = &endAt
+	}
+	switch req.Kind {
+	case KindTransfer:
+		recipientID, err := s.users.RecipientID(ctx, req.RecipientEmail)
+		if err != nil {
+			return nil, err
+		}
+		if recipientID == userID {
+			return nil, utils.ErrorSelfTransfer
+		}
+		schedule.RecipientID = &recipientID
+	case KindExchange:
+		schedule.ToCurrency = &req.ToCurrency
+	}
+
+	id, err := s.repository.CreateSchedule(ctx, schedule)
+	if err != nil {
+		log.Error("failed to create schedule", logger.Err(err))
+		return nil, err
+	}
+	return s.repository.GetSchedule(ctx, id, userID)
+}
+
+func (s *Service) GetSchedule(ctx context.Context, userID, id uuid.UUID) (*ScheduleDB, error) {
+	return s.repository.GetSchedule(ctx, id, userID)
+}
+
+func (s *Service) ListSchedules(ctx context.Context, userID uuid.UUID) ([]*ScheduleDB, error) {
+	return s.repository.ListSchedules(ctx, userID)
+}
+
+// UpdateSchedule меняет сумму, дату окончания или ставит расписание на паузу.
+// При возобновлении пропущенные за время паузы запуски не выполняются.
+func (s *Service) UpdateSchedule(ctx context.Context, userID, id uuid.UUID, req UpdateScheduleRequest) (*ScheduleDB, error) {
+	const op = "Schedule.Service.UpdateSchedule"
+	log := s.log.With(slog.String("op", op))
+
+	schedule, err := s.repository.GetSchedule(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Status != StatusActive && schedule.Status != StatusPaused {
+		return nil, utils.ErrorScheduleClosed
+	}
+	if schedule.Version != req.Version {
+		return nil, utils.ErrorVersionConflict
+	}
+	if req.Amount != nil {
+		if err := s.users.RequireStepUp(ctx, userID, *req.Amount, schedule.Currency, req.TOTPCode); err != nil {
+			return nil, err
+		}
+		schedule.Amount = *req.Amount
+	}
+	if req.EndAt != nil {
+		endAt := req.EndAt.UTC()
+		if !endAt.After(schedule.StartAt) {
+			return nil, &api.ValidationError{Fields: []api.FieldError{{Field: "end_at", Rule: "gtfield", Param: "start_at"}}}
+		}
+		schedule.EndAt = &endAt
+	}
+	if req.Status != nil {
+		if schedule.Status == StatusPaused && *req.Status == StatusActive && schedule.Frequency != FrequencyOnce {
+			now := time.Now().UTC()
+			for schedule.Frequency.At(schedule.StartAt, schedule.Occurrence).Before(now) {
+				schedule.Occurrence++
+			}
+		}
+		schedule.Status = *req.Status
+	}
+	schedule.NextRunAt = schedule.next(schedule.Occurrence)
+	if schedule.Frequency == FrequencyOnce {
+		schedule.NextRunAt = &schedule.StartAt
+	}
+	if schedule.NextRunAt == nil {
+		schedule.Status = StatusCompleted
+	}
+
+	if err := s.repository.UpdateSchedule(ctx, schedule, req.Version); err != nil {
+		if !errors.Is(err, utils.ErrorVersionConflict) {
+			log.Error("failed to update schedule", logger.Err(err))
+		}
+		return nil, err
+	}
+	return s.repository.GetSchedule(ctx, id, userID)
+}
+
+func (s *Service) CancelSchedule(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repository.GetSchedule(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repository.CancelSchedule(ctx, id, userID)
+}
+
+// StartScheduler по тикеру выполняет наступившие запуски, аналогично StartCreateEvent.
+func (s *Service) StartScheduler(ctx context.Context, handlePeriod, lease time.Duration, limit uint64) {
+	const op = "Schedule.Service.StartScheduler"
+
+	log := s.log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping scheduler")
+				return
+
+			case <-ticker.C:
+				schedules, err := s.repository.ClaimDueSchedules(ctx, time.Now().UTC(), lease, limit)
+				if err != nil {
+					log.Error("failed to claim due schedules", logger.Err(err))
+					continue
+				}
+				if len(schedules) == 0 {
+					log.Debug("no due schedules")
+					continue
+				}
+				for _, schedule := range schedules {
+					if err := s.runSchedule(ctx, schedule); err != nil {
+						log.Error("failed to run schedule", slog.String("schedule_id", schedule.ID.String()), logger.Err(err))
+					}
+				}
+				log.Info("processed due schedules", slog.Int("count", len(schedules)))
+			}
+		}
+	}()
+}
+
+// runSchedule выполняет один запуск. Нехватка средств пропускает запуск,
+// временные ошибки оставляют его до истечения блокировки.
+func (s *Service) runSchedule(ctx context.Context, schedule *ScheduleDB) error {
+	key := runKey(schedule)
+
+	var err error
+	switch schedule.Kind {
+	case KindTransfer:
+		_, err = s.wallet.Transfer(ctx, schedule.UserID, *schedule.RecipientID, schedule.Currency, schedule.Amount, key)
+	case KindExchange:
+		_, err = s.wallet.ExchangeAtMarket(ctx, schedule.UserID, schedule.Currency, *schedule.ToCurrency, schedule.Amount, key)
+	}
+
+	run, err := newRun(schedule, err)
+	if err != nil {
+		return err
+	}
+	return s.finishRun(ctx, schedule, run)
+}
+
+// runKey — ключ идемпотентности запуска. Он привязан к номеру запуска,
+// поэтому повтор после сбоя не спишет деньги второй раз.
+func runKey(schedule *ScheduleDB) string {
+	return fmt.Sprintf("schedule:%s:%d", schedule.ID, schedule.Occurrence)
+}
+
+// newRun записывает результат операции запуска. Временную ошибку newRun возвращает как есть:
+// такой запуск не записывается и будет повторён.
+func newRun(schedule *ScheduleDB, err error) (*RunDB, error) {
+	run := &RunDB{
+		ScheduleID:  schedule.ID,
+		Occurrence:  schedule.Occurrence,
+		ScheduledAt: *schedule.NextRunAt,
+		Result:      RunExecuted,
+	}
+	switch appErr := utils.AsError(err); {
+	case err == nil, errors.Is(err, utils.ErrorDuplicateOperation):
+	case errors.Is(err, utils.ErrorInsufficientFunds):
+		run.Result = RunSkipped
+		run.ErrorCode = &appErr.Code
+	case appErr.Kind == utils.KindInternal, appErr.Kind == utils.KindUnavailable:
+		return nil, err
+	default:
+		run.Result = RunFailed
+		run.ErrorCode = &appErr.Code
+	}
+	return run, nil
+}
+
+func (s *Service) finishRun(ctx context.Context, schedule *ScheduleDB, run *RunDB) error {
+	return s.tx.Do(ctx, func(ctx context.Context) error {
+		created, err := s.repository.CreateRun(ctx, run)
+		if err != nil {
+			return err
+		}
+		if err = s.repository.AdvanceSchedule(ctx, run, schedule.next(run.Occurrence+1)); err != nil {
+			return err
+		}
+		// событие уже было отправлено при первой обработке запуска
+		if created {
+			payload, err := s.runPayload(ctx, schedule, run)
+			if err != nil {
+				return err
+			}
+			if _, err = s.events.CreateEvent(ctx, eventTypes[run.Result], payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var eventTypes = map[RunResult]string{
+	RunExecuted: EventTypeExecuted,
+	RunSkipped:  EventTypeSkipped,
+	RunFailed:   EventTypeFailed,
+}
+
+var notifications = map[RunResult]string{
+	RunExecuted: NotificationExecuted,
+	RunSkipped:  NotificationSkipped,
+	RunFailed:   NotificationFailed,
+}
+
+// runPayload собирает событие о запуске. Если владельца расписания уже нет, событие пишется на языке
+// по умолчанию: иначе запуск откатывался бы и повторялся бесконечно.
+func (s *Service) runPayload(ctx context.Context, schedule *ScheduleDB, run *RunDB) (string, error) {
+	locale, err := s.users.NotificationLocale(ctx, schedule.UserID)
+	if errors.Is(err, utils.ErrorUserNotFound) {
+		locale, err = i18n.FromContext(ctx), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	code := notifications[run.Result]
+	payload := KafkaPayloadSchedule{
+		ScheduleID:  schedule.ID,
+		UserID:      schedule.UserID,
+		Kind:        schedule.Kind,
+		Occurrence:  run.Occurrence,
+		ScheduledAt: run.ScheduledAt,
+		Result:      run.Result,
+		Amount:      schedule.Amount,
+		Currency:    schedule.Currency,
+		ToCurrency:  schedule.ToCurrency,
+		RecipientID: schedule.RecipientID,
+		Code:        code,
+		Locale:      string(locale),
+	}
+	reason := ""
+	if run.ErrorCode != nil {
+		payload.ErrorCode = *run.ErrorCode
+		reason = i18n.Message(locale, *run.ErrorCode)
+	}
+	payload.Message = i18n.Message(locale, code, schedule.Amount, schedule.Currency, reason)
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
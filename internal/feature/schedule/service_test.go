@@ -0,0 +1,162 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 9, 30, 0, 0, time.UTC)
+}
+
+// TestFrequencyAt — время n-го запуска, для ежемесячных расписаний день прижимается к концу месяца.
+func TestFrequencyAt(t *testing.T) {
+	cases := []struct {
+		name  string
+		f     Frequency
+		start time.Time
+		n     int
+		want  time.Time
+	}{
+		{"daily", FrequencyDaily, date(2025, time.January, 31), 1, date(2025, time.February, 1)},
+		{"weekly", FrequencyWeekly, date(2025, time.December, 29), 1, date(2026, time.January, 5)},
+		{"month end in leap year", FrequencyMonthly, date(2024, time.January, 31), 1, date(2024, time.February, 29)},
+		{"month end", FrequencyMonthly, date(2025, time.January, 31), 1, date(2025, time.February, 28)},
+		{"day restored after short month", FrequencyMonthly, date(2025, time.January, 31), 2, date(2025, time.March, 31)},
+		{"day clamped to 30", FrequencyMonthly, date(2025, time.January, 31), 3, date(2025, time.April, 30)},
+		{"day 30 in february", FrequencyMonthly, date(2025, time.January, 30), 1, date(2025, time.February, 28)},
+		{"mid month", FrequencyMonthly, date(2025, time.January, 15), 1, date(2025, time.February, 15)},
+		{"across year", FrequencyMonthly, date(2025, time.November, 30), 3, date(2026, time.February, 28)},
+		{"first run", FrequencyMonthly, date(2025, time.January, 31), 0, date(2025, time.January, 31)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.f.At(c.start, c.n); !got.Equal(c.want) {
+				t.Errorf("At(%s, %d) = %s, want %s", c.start.Format(time.DateOnly), c.n, got, c.want)
+			}
+		})
+	}
+}
+
+// TestScheduleNext — разовое расписание и расписание после end_at исчерпаны.
+func TestScheduleNext(t *testing.T) {
+	end := date(2025, time.March, 31)
+	s := &ScheduleDB{Frequency: FrequencyMonthly, StartAt: date(2025, time.January, 31), EndAt: &end}
+	if got := s.next(2); got == nil || !got.Equal(end) {
+		t.Errorf("next(2) = %v, want %s", got, end)
+	}
+	if got := s.next(3); got != nil {
+		t.Errorf("next after end_at = %s, want nil", got)
+	}
+	once := &ScheduleDB{Frequency: FrequencyOnce, StartAt: date(2025, time.January, 31)}
+	if got := once.next(1); got != nil {
+		t.Errorf("next of one-off schedule = %s, want nil", got)
+	}
+}
+
+// TestRunKey — у каждого запуска свой ключ идемпотентности, повтор запуска получает тот же ключ.
+func TestRunKey(t *testing.T) {
+	s := &ScheduleDB{ID: uuid.New(), Occurrence: 3}
+	key := runKey(s)
+	if want := fmt.Sprintf("schedule:%s:3", s.ID); key != want {
+		t.Errorf("key = %q, want %q", key, want)
+	}
+	if again := runKey(s); again != key {
+		t.Errorf("retry key = %q, want %q", again, key)
+	}
+	s.Occurrence++
+	if next := runKey(s); next == key {
+		t.Errorf("next occurrence reuses key %q", key)
+	}
+	if other := runKey(&ScheduleDB{ID: uuid.New(), Occurrence: 3}); other == key {
+		t.Errorf("other schedule reuses key %q", key)
+	}
+}
+
+// TestNewRun — нехватка средств пропускает запуск, уже выполненная операция считается выполненной,
+// временные ошибки запуск не записывают.
+func TestNewRun(t *testing.T) {
+	at := date(2025, time.February, 28)
+	s := &ScheduleDB{ID: uuid.New(), Occurrence: 1, NextRunAt: &at}
+	cases := []struct {
+		name     string
+		err      error
+		want     RunResult
+		wantCode string
+		retry    bool
+	}{
+		{name: "executed", want: RunExecuted},
+		{name: "already executed", err: utils.ErrorDuplicateOperation, want: RunExecuted},
+		{name: "insufficient funds", err: fmt.Errorf("withdraw: %w", utils.ErrorInsufficientFunds), want: RunSkipped, wantCode: "insufficient_funds"},
+		{name: "rejected", err: utils.ErrorEmailNotVerified, want: RunFailed, wantCode: "email_not_verified"},
+		{name: "account closed", err: utils.ErrorUserNotFound, want: RunFailed, wantCode: "user_not_found"},
+		{name: "rate unavailable", err: utils.ErrorExchangeRateUnavailable, retry: true},
+		{name: "database error", err: errors.New("connection reset"), retry: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			run, err := newRun(s, c.err)
+			if c.retry {
+				if err == nil {
+					t.Fatalf("run recorded as %s, want retry", run.Result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newRun: %v", err)
+			}
+			if run.Result != c.want || run.Occurrence != 1 || !run.ScheduledAt.Equal(at) {
+				t.Errorf("run = %+v, want %s of occurrence 1 at %s", run, c.want, at)
+			}
+			code := ""
+			if run.ErrorCode != nil {
+				code = *run.ErrorCode
+			}
+			if code != c.wantCode {
+				t.Errorf("error code = %q, want %q", code, c.wantCode)
+			}
+		})
+	}
+}
+
+// closedOwner — пользователи, у которых владельца расписания уже нет.
+type closedOwner struct {
+	ServiceUsers
+}
+
+func (closedOwner) NotificationLocale(_ context.Context, _ uuid.UUID) (i18n.Locale, error) {
+	return "", utils.ErrorUserNotFound
+}
+
+// TestRunPayloadClosedOwner — событие о запуске закрытого аккаунта пишется на языке по умолчанию,
+// а не откатывает запуск.
+func TestRunPayloadClosedOwner(t *testing.T) {
+	at := date(2025, time.February, 28)
+	schedule := &ScheduleDB{ID: uuid.New(), UserID: uuid.New(), Kind: KindTransfer, Occurrence: 1, NextRunAt: &at, Amount: 10, Currency: "USD"}
+	run, err := newRun(schedule, utils.ErrorUserNotFound)
+	if err != nil {
+		t.Fatalf("newRun: %v", err)
+	}
+	s := NewService(nil, nil, closedOwner{}, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	payload, err := s.runPayload(context.Background(), schedule, run)
+	if err != nil {
+		t.Fatalf("runPayload: %v", err)
+	}
+	var got KafkaPayloadSchedule
+	if err := json.Unmarshal([]byte(payload), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.Locale != string(i18n.Default) || got.Result != RunFailed || got.ErrorCode != "user_not_found" {
+		t.Errorf("payload = %+v, want failed run in %s", got, i18n.Default)
+	}
+}
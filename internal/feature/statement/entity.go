@@ -0,0 +1,68 @@
+package statement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FormatCSV = "csv"
+	FormatPDF = "pdf"
+)
+
+const dateLayout = "2006-01-02"
+
+// Request — параметры выписки. Даты включительные и считаются в UTC.
+type Request struct {
+	From     string `json:"from" validate:"required,datetime=2006-01-02"`
+	To       string `json:"to" validate:"required,datetime=2006-01-02"`
+	Currency string `json:"currency" validate:"omitempty,currency"`
+	Format   string `json:"format" validate:"omitempty,oneof=csv pdf"`
+}
+
+// Period — полуинтервал [From, To), To — начало дня после последнего дня выписки.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// LastDay — последний день выписки для отображения.
+func (p Period) LastDay() time.Time {
+	return p.To.AddDate(0, 0, -1)
+}
+
+// Summary — остатки кошелька на начало и конец периода в копейках.
+type Summary struct {
+	WalletID uuid.UUID
+	Currency string
+	Opening  int64
+	Closing  int64
+}
+
+// Тип строки выписки: переводы разделены на входящие и исходящие.
+const (
+	EntryDeposit     = "DEPOSIT"
+	EntryWithdraw    = "WITHDRAW"
+	EntryTransferIn  = "TRANSFER_IN"
+	EntryTransferOut = "TRANSFER_OUT"
+)
+
+// Entry — операция по кошельку. Amount в копейках со знаком: списания отрицательные.
+type Entry struct {
+	ID        uuid.UUID
+	Type      string
+	Amount    int64
+	Reference *string
+	CreatedAt time.Time
+}
+
+// formatCents печатает сумму в копейках как десятичное число с двумя знаками.
+func formatCents(c int64) string {
+	sign := ""
+	if c < 0 {
+		sign, c = "-", -c
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, c/100, c%100)
+}
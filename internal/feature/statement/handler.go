@@ -0,0 +1,124 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	httphandlers "github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+type HandlerStatements interface {
+	Export(ctx context.Context, userID uuid.UUID, req Request, w io.Writer) error
+}
+
+type Handler struct {
+	s   HandlerStatements
+	log *slog.Logger
+}
+
+func NewHandler(s HandlerStatements, log *slog.Logger) *Handler {
+	return &Handler{
+		s:   s,
+		log: log,
+	}
+}
+
+// streamWriteTimeout — сколько ждать следующую порцию выписки. Общий WriteTimeout сервера
+// рассчитан на короткие ответы, поэтому на каждой записи срок сдвигается вперёд.
+const streamWriteTimeout = 30 * time.Second
+
+// attachment выставляет заголовки файла перед первой записью тела,
+// чтобы ошибку до начала выписки ещё можно было отдать обычным JSON-ответом.
+type attachment struct {
+	w           http.ResponseWriter
+	rc          *http.ResponseController
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (a *attachment) Write(p []byte) (int, error) {
+	if err := a.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return 0, err
+	}
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", a.contentType)
+		a.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, a.filename))
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
+
+// @Summary Statement
+// @ID getStatement
+// @Tags statements
+// @Description account statement for the period: opening balance, every transaction with running balance
+// @Description and closing balance per currency. Dates are inclusive and in UTC. Large periods are streamed
+// @Produce text/csv,application/pdf,json
+// @Param from query string true "first day, YYYY-MM-DD"
+// @Param to query string true "last day, YYYY-MM-DD"
+// @Param currency query string false "only this currency"
+// @Param format query string false "file format" Enums(csv, pdf) default(csv)
+// @Success 200 {file} file
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /statements [get]
+func (h *Handler) StatementHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Statement.Handler.Statement"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	query := r.URL.Query()
+	req := Request{
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+		Currency: query.Get("currency"),
+		Format:   query.Get("format"),
+	}
+	if err := request.Validate(&req); err != nil {
+		api.WriteError(w, r, err)
+		return
+	}
+	if req.Format == "" {
+		req.Format = FormatCSV
+	}
+	out := &attachment{
+		w:           w,
+		rc:          http.NewResponseController(w),
+		contentType: "text/csv; charset=utf-8",
+		filename:    fmt.Sprintf("statement_%s_%s.%s", req.From, req.To, req.Format),
+	}
+	if req.Format == FormatPDF {
+		out.contentType = "application/pdf"
+	}
+	if err := h.s.Export(r.Context(), claims.ID, req, out); err != nil {
+		if out.started {
+			log.Error("statement interrupted", logger.Err(err))
+			return
+		}
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to export statement", logger.Err(err))
+		}
+		api.WriteError(w, r, err)
+	}
+}
@@ -0,0 +1,122 @@
+package statement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/pdf"
+)
+
+// renderer получает выписку по частям в порядке: остаток на начало, операции, остаток на конец по каждой валюте.
+type renderer interface {
+	Opening(s Summary) error
+	Entry(currency string, e Entry, balance int64) error
+	Closing(s Summary) error
+	Close() error
+}
+
+func newRenderer(format string, w io.Writer, period Period, generatedAt time.Time) (renderer, error) {
+	switch format {
+	case FormatPDF:
+		return newPDFRenderer(w, period, generatedAt)
+	default:
+		return newCSVRenderer(w, period)
+	}
+}
+
+type csvRenderer struct {
+	w      *csv.Writer
+	period Period
+}
+
+func newCSVRenderer(w io.Writer, period Period) (*csvRenderer, error) {
+	c := &csvRenderer{w: csv.NewWriter(w), period: period}
+	return c, c.w.Write([]string{"currency", "date", "type", "reference", "transaction_id", "amount", "balance"})
+}
+
+func (c *csvRenderer) Opening(s Summary) error {
+	return c.w.Write([]string{s.Currency, c.period.From.Format(dateLayout), "OPENING_BALANCE", "", "", "", formatCents(s.Opening)})
+}
+
+func (c *csvRenderer) Entry(currency string, e Entry, balance int64) error {
+	var ref string
+	if e.Reference != nil {
+		ref = *e.Reference
+	}
+	return c.w.Write([]string{
+		currency,
+		e.CreatedAt.UTC().Format(time.RFC3339),
+		e.Type,
+		ref,
+		e.ID.String(),
+		formatCents(e.Amount),
+		formatCents(balance),
+	})
+}
+
+func (c *csvRenderer) Closing(s Summary) error {
+	return c.w.Write([]string{s.Currency, c.period.LastDay().Format(dateLayout), "CLOSING_BALANCE", "", "", "", formatCents(s.Closing)})
+}
+
+func (c *csvRenderer) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// pdfRenderer печатает выписку текстовой таблицей. Подписи на английском:
+// встроенный шрифт PDF не содержит кириллицы.
+type pdfRenderer struct {
+	w *pdf.Writer
+}
+
+const pdfRow = "%-20s  %-12s  %14s  %14s  %s"
+
+func newPDFRenderer(w io.Writer, period Period, generatedAt time.Time) (*pdfRenderer, error) {
+	p := &pdfRenderer{w: pdf.NewWriter(w)}
+	for _, line := range []string{
+		"Account statement",
+		fmt.Sprintf("Period: %s - %s (UTC)", period.From.Format(dateLayout), period.LastDay().Format(dateLayout)),
+		"Generated: " + generatedAt.UTC().Format(time.RFC3339),
+		"",
+	} {
+		if err := p.w.Line(line); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *pdfRenderer) Opening(s Summary) error {
+	for _, line := range []string{
+		"Currency: " + s.Currency,
+		fmt.Sprintf(pdfRow, "Date", "Type", "Amount", "Balance", "Reference"),
+		fmt.Sprintf(pdfRow, "", "OPENING", "", formatCents(s.Opening), ""),
+	} {
+		if err := p.w.Line(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pdfRenderer) Entry(_ string, e Entry, balance int64) error {
+	var ref string
+	if e.Reference != nil {
+		ref = *e.Reference
+	}
+	return p.w.Line(fmt.Sprintf(pdfRow,
+		e.CreatedAt.UTC().Format("2006-01-02 15:04:05"), e.Type, formatCents(e.Amount), formatCents(balance), ref))
+}
+
+func (p *pdfRenderer) Closing(s Summary) error {
+	if err := p.w.Line(fmt.Sprintf(pdfRow, "", "CLOSING", "", formatCents(s.Closing), "")); err != nil {
+		return err
+	}
+	return p.w.Line("")
+}
+
+func (p *pdfRenderer) Close() error {
+	return p.w.Close()
+}
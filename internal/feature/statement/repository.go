@@ -0,0 +1,120 @@
+package statement
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	primaryDB *pgxpool.Pool
+}
+
+func NewRepository(primaryDB *pgxpool.Pool) *Repository {
+	return &Repository{primaryDB: primaryDB}
+}
+
+// delta — влияние операции на кошелёк w в копейках. Перевод пишется одной строкой
+// на кошелёк получателя, для отправителя (sender_wallet_id) это списание.
+const delta = `CASE WHEN t.type = 'WITHDRAW' OR (t.type = 'TRANSFER' AND t.sender_wallet_id = w.id)
+	THEN -(t.amount * 100)::BIGINT ELSE (t.amount * 100)::BIGINT END`
+
+// Summaries считает остатки на начало и конец периода от текущего баланса назад:
+// из баланса вычитаются все операции, прошедшие после границы периода.
+func (r *Repository) Summaries(ctx context.Context, userID uuid.UUID, period Period, currency string) ([]Summary, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	builder := sq.
+		Select(
+			"w.id",
+			"w.currency",
+			"(COALESCE(w.balance, 0) * 100)::BIGINT - COALESCE(SUM(d.delta), 0)::BIGINT",
+		).
+		Column(sq.Expr("(COALESCE(w.balance, 0) * 100)::BIGINT - COALESCE(SUM(d.delta) FILTER (WHERE d.created_at >= ?), 0)::BIGINT", period.To)).
+		From("wallets w").
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT t.created_at, `+delta+` AS delta
+			FROM transactions t
+			WHERE (t.wallet_id = w.id OR t.sender_wallet_id = w.id) AND t.created_at >= ?
+		) d ON TRUE`, period.From).
+		Where(sq.Eq{"w.user_id": userID}).
+		GroupBy("w.id", "w.currency", "w.balance").
+		OrderBy("w.currency").
+		PlaceholderFormat(sq.Dollar)
+	if currency != "" {
+		builder = builder.Where(sq.Eq{"w.currency": currency})
+	}
+	query, arg, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := tx.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	summaries := make([]Summary, 0)
+	for rows.Next() {
+		var s Summary
+		if err := rows.Scan(&s.WalletID, &s.Currency, &s.Opening, &s.Closing); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// StreamEntries передаёт операции кошелька за период в fn по одной, не загружая их все в память.
+func (r *Repository) StreamEntries(ctx context.Context, walletID uuid.UUID, period Period, fn func(Entry) error) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	query, arg, err := sq.
+		Select(
+			"t.id",
+			`CASE
+				WHEN t.type = 'TRANSFER' AND t.sender_wallet_id = w.id THEN 'TRANSFER_OUT'
+				WHEN t.type = 'TRANSFER' THEN 'TRANSFER_IN'
+				ELSE t.type::TEXT
+			END`,
+			delta,
+			"t.idempotency_key",
+			"t.created_at",
+		).
+		From("transactions t").
+		Join("wallets w ON w.id = ?", walletID).
+		Where("(t.wallet_id = w.id OR t.sender_wallet_id = w.id)").
+		Where(sq.GtOrEq{"t.created_at": period.From}).
+		Where(sq.Lt{"t.created_at": period.To}).
+		OrderBy("t.created_at", "t.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	rows, err := tx.Query(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var e Entry
+		if err := rows.Scan(&e.ID, &e.Type, &e.Amount, &e.Reference, &e.CreatedAt); err != nil {
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
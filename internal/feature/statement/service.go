@@ -0,0 +1,95 @@
+package statement
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ServiceStatements interface {
+	Summaries(ctx context.Context, userID uuid.UUID, period Period, currency string) ([]Summary, error)
+	StreamEntries(ctx context.Context, walletID uuid.UUID, period Period, fn func(Entry) error) error
+}
+
+type Service struct {
+	repository ServiceStatements
+	tx         *db.TxManager
+	log        *slog.Logger
+}
+
+func NewService(r ServiceStatements, tx *db.TxManager, log *slog.Logger) *Service {
+	return &Service{
+		repository: r,
+		tx:         tx,
+		log:        log,
+	}
+}
+
+// ParsePeriod переводит включительные даты запроса в полуинтервал.
+func ParsePeriod(req Request) (Period, error) {
+	from, err := time.Parse(dateLayout, req.From)
+	if err != nil {
+		return Period{}, utils.ErrorStatementPeriodInvalid
+	}
+	to, err := time.Parse(dateLayout, req.To)
+	if err != nil || to.Before(from) {
+		return Period{}, utils.ErrorStatementPeriodInvalid
+	}
+	return Period{From: from, To: to.AddDate(0, 0, 1)}, nil
+}
+
+// Export пишет выписку в w в формате req.Format. Остатки и операции читаются в одном снимке
+// базы, поэтому сумма операций сходится с остатками даже при параллельных изменениях.
+// Операции идут в w по мере чтения из базы: ошибка после начала записи оставляет файл обрезанным.
+func (s *Service) Export(ctx context.Context, userID uuid.UUID, req Request, w io.Writer) error {
+	const op = "Statement.Service.Export"
+	log := s.log.With(slog.String("op", op))
+
+	period, err := ParsePeriod(req)
+	if err != nil {
+		return err
+	}
+	// запись в w нельзя повторить, поэтому транзакция выполняется один раз
+	opts := db.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly, Attempts: 1}
+	return s.tx.DoWithOptions(ctx, opts, func(ctx context.Context) error {
+		summaries, err := s.repository.Summaries(ctx, userID, period, req.Currency)
+		if err != nil {
+			return err
+		}
+		out, err := newRenderer(req.Format, w, period, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, summary := range summaries {
+			if err := out.Opening(summary); err != nil {
+				return err
+			}
+			balance := summary.Opening
+			err := s.repository.StreamEntries(ctx, summary.WalletID, period, func(e Entry) error {
+				balance += e.Amount
+				return out.Entry(summary.Currency, e, balance)
+			})
+			if err != nil {
+				return err
+			}
+			if balance != summary.Closing {
+				// журнал операций неполный (например, данные до его появления): остаток на конец берётся из баланса
+				log.Warn("statement running balance does not match closing balance",
+					slog.String("wallet_id", summary.WalletID.String()),
+					slog.Int64("running", balance),
+					slog.Int64("closing", summary.Closing),
+				)
+			}
+			if err := out.Closing(summary); err != nil {
+				return err
+			}
+		}
+		return out.Close()
+	})
+}
@@ -14,9 +14,55 @@ type DatabaseUser struct {
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 	Version   int64     `db:"version"`
+
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
+	Locale          *string    `db:"locale"`
+
+	TwoFactorEnabled bool `db:"-"`
+}
+
+// Role — роль пользователя. Администраторы видят журнал аудита, роль меняется через walletctl.
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) Valid() bool {
+	return r == RoleUser || r == RoleAdmin
+}
+
+type SessionDB struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Device     string     `db:"device"`
+	IP         string     `db:"ip"`
+	UserAgent  string     `db:"user_agent"`
+	CreatedAt  time.Time  `db:"created_at"`
+	LastSeenAt time.Time  `db:"last_seen_at"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+type TOTPDB struct {
+	UserID          uuid.UUID  `db:"user_id"`
+	SecretEncrypted []byte     `db:"secret_encrypted"`
+	LastUsedStep    int64      `db:"last_used_step"`
+	EnabledAt       *time.Time `db:"enabled_at"`
+}
+
+// SigningKeyDB — ключ подписи JWT, секрет зашифрован secretbox.
+type SigningKeyDB struct {
+	Kid             string     `db:"kid"`
+	SecretEncrypted []byte     `db:"secret_encrypted"`
+	ActivatesAt     time.Time  `db:"activates_at"`
+	RetiredAt       *time.Time `db:"retired_at"`
+	CreatedAt       time.Time  `db:"created_at"`
 }
+
 type AuthRequest struct {
-	Email    string `json:"email" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
 	Username string `json:"username" validate:"required,min=1,max=100"`
 	Password string `json:"password" validate:"required,min=6"`
 }
@@ -26,12 +72,120 @@ type AuthResponse struct {
 }
 
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required"`
 }
 
 type LoginResponse struct {
 	api.Response
-	Email    string `json:"email"`
-	Username string `json:"username" `
+	Email             string `json:"email"`
+	Username          string `json:"username" `
+	TwoFactorRequired bool   `json:"two_factor_required,omitempty"`
+	ChallengeToken    string `json:"challenge_token,omitempty"`
+}
+
+type EmailRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type TokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type PasswordResetConfirmRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=6"`
+}
+
+type TwoFactorEnrollResponse struct {
+	api.Response
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type TwoFactorEnableResponse struct {
+	api.Response
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required"`
+}
+
+type ProfileResponse struct {
+	api.Response
+	ID            uuid.UUID `json:"id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Version       int64     `json:"version"`
+	Locale        string    `json:"locale,omitempty"`
+}
+
+type UpdateProfileRequest struct {
+	Username *string `json:"username,omitempty" validate:"omitempty,min=1,max=100"`
+	Email    *string `json:"email,omitempty" validate:"omitempty,email"`
+	Locale   *string `json:"locale,omitempty" validate:"omitempty,oneof=en ru"`
+	Version  int64   `json:"version" validate:"required,min=1"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=6"`
+}
+
+type CloseAccountRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+func NewProfileResponse(user *DatabaseUser) ProfileResponse {
+	var locale string
+	if user.Locale != nil {
+		locale = *user.Locale
+	}
+	return ProfileResponse{
+		Response:      api.OK(),
+		ID:            user.ID,
+		Username:      user.Name,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerifiedAt != nil,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+		Version:       user.Version,
+		Locale:        locale,
+	}
+}
+
+type Session struct {
+	ID         uuid.UUID `json:"id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+type SessionsResponse struct {
+	api.Response
+	Sessions []Session `json:"sessions"`
+}
+
+type NewDeviceLoginPayload struct {
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID uuid.UUID `json:"session_id"`
+	Device    string    `json:"device"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	LoginAt   time.Time `json:"login_at"`
+	Code      string    `json:"code"`
+	Locale    string    `json:"locale"`
+	Message   string    `json:"message"`
 }
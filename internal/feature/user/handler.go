@@ -6,12 +6,13 @@ import (
 
 	"github.com/Sanchir01/currency-wallet/pkg/logger"
 	"github.com/Sanchir01/currency-wallet/pkg/utils"
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
 
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
 	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
 )
@@ -25,6 +26,21 @@ type Handler struct {
 type HandlerUser interface {
 	Register(ctx context.Context, email, username, password string) (*uuid.UUID, error)
 	Login(ctx context.Context, email, password string) (*DatabaseUser, error)
+	RequestEmailVerification(ctx context.Context, userID uuid.UUID) error
+	ConfirmEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, password string) error
+	EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (secret, uri string, err error)
+	EnableTwoFactor(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, userID uuid.UUID, code string) error
+	CompleteTwoFactorLogin(ctx context.Context, challengeToken, code string) (*DatabaseUser, error)
+	GetProfile(ctx context.Context, userID uuid.UUID) (*DatabaseUser, error)
+	UpdateProfile(ctx context.Context, userID uuid.UUID, version int64, username, email, locale *string) (*DatabaseUser, error)
+	ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, currentPassword, newPassword string) error
+	CloseAccount(ctx context.Context, userID uuid.UUID, password string) error
+	StartSession(ctx context.Context, userID uuid.UUID, meta SessionMeta, notifyNewDevice bool) (uuid.UUID, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*SessionDB, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
 }
 
 func NewHandler(s HandlerUser, lg *slog.Logger) *Handler {
@@ -34,14 +50,15 @@ func NewHandler(s HandlerUser, lg *slog.Logger) *Handler {
 	}
 }
 
-// @Summary Auth
+// @Summary Register
+// @ID register
 // @Tags auth
 // @Description register user
 // @Accept json
 // @Produce json
-// @Param input body LoginRequest true "login body"
+// @Param input body AuthRequest true "register body"
 // @Success 201 {object}  AuthResponse
-// @Failure 400,404 {object}  api.Response
+// @Failure 400,409 {object}  api.Response
 // @Failure 500 {object}  api.Response
 // @Router /register [post]
 func (h *Handler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
@@ -51,37 +68,22 @@ func (h *Handler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 		slog.String("request_id", middleware.GetReqID(r.Context())),
 	)
 	var req AuthRequest
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		log.Error("failed to decode request body", slog.Any("err", err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("Ошибка при валидации данных"))
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		log.Error("invalid request", logger.Err(err))
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, api.Error("invalid request"))
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
 	id, err := h.Service.Register(r.Context(), req.Email, req.Username, req.Password)
-	if errors.Is(err, utils.ErrorUserAlreadyExists) {
-		log.Error("user already exists", logger.Err(err))
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, api.Error("Пользователь с таким email или username уже существует"))
-		return
-	}
 	if err != nil {
 		log.Error("failed to register user", logger.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("ошибка регистрации"))
+		api.WriteError(w, r, err)
 		return
 	}
 	log.Info("login success")
 
-	if err = AddCookieTokens(*id, w, "localhost"); err != nil {
-		log.Error("register cookie errors", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("failed to register cookie"))
+	if err = h.startSession(w, r, *id, false); err != nil {
+		log.Error("register cookie errors", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
 	render.Status(r, http.StatusCreated)
@@ -91,47 +93,334 @@ func (h *Handler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Login
+// @ID login
 // @Tags auth
-// @Description login user
+// @Description login user. When two-factor authentication is enabled no cookies are set,
+// @Description the response contains a challenge_token for /2fa/login instead
 // @Accept json
 // @Produce json
 // @Param input body LoginRequest true "auth body"
 // @Success 200 {object}  LoginResponse
-// @Failure 400,404 {object}  api.Response
+// @Failure 400,401,429 {object}  api.Response
 // @Failure 500 {object}  api.Response
 // @Router /login [post]
 func (h *Handler) LoginHandler(w http.ResponseWriter, r *http.Request) {
 	const op = "User.Handler.Login"
 	log := h.log.With(slog.String("op", op))
 	var req LoginRequest
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		log.Error("failed to decode request body", slog.Any("err", err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("Ошибка при валидации данных"))
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
-	if err := validator.New().Struct(req); err != nil {
-		log.Error("invalid request", logger.Err(err))
-		render.JSON(w, r, api.Error("invalid request"))
+	user, err := h.Service.Login(r.Context(), req.Email, req.Password)
+	if errors.Is(err, utils.ErrorUserNotFound) || errors.Is(err, utils.ErrorInvalidPassword) {
+		// не раскрываем, что именно не так: email или пароль
+		log.Warn("invalid credentials", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorInvalidCredentials)
 		return
 	}
-	user, err := h.Service.Login(r.Context(), req.Email, req.Password)
-	if errors.Is(err, utils.ErrorUserNotFound) {
-		log.Error("invalid login", logger.Err(err))
-		render.Status(r, http.StatusNotFound)
-		render.JSON(w, r, api.Error("Неправильный логин"))
+	if err != nil {
+		log.Error("failed to login", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
-	if errors.Is(err, utils.ErrorInvalidPassword) {
-		log.Error("invalid password", logger.Err(err))
-		render.Status(r, http.StatusNotFound)
-		render.JSON(w, r, api.Error("Неправильный логин"))
+	if user.TwoFactorEnabled {
+		challenge, err := GenerateChallengeToken(user.ID)
+		if err != nil {
+			log.Error("failed to generate challenge token", logger.Err(err))
+			api.WriteError(w, r, err)
+			return
+		}
+		render.JSON(w, r, LoginResponse{
+			Response:          api.OK(),
+			Email:             user.Email,
+			Username:          user.Name,
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+		})
 		return
 	}
+	if err := h.startSession(w, r, user.ID, true); err != nil {
+		log.Error("login cookie errors", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, LoginResponse{
+		Response: api.OK(),
+		Email:    user.Email,
+		Username: user.Name,
+	})
+}
+
+// @Summary RequestEmailVerification
+// @ID requestEmailVerification
+// @Tags auth
+// @Description send a new email verification link
+// @Produce json
+// @Success 200 {object}  api.Response
+// @Failure 401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /verify-email/request [post]
+func (h *Handler) RequestEmailVerificationHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.RequestEmailVerification"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
 	if err != nil {
-		log.Error("failed to login", logger.Err(err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("internal server error"))
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	if err := h.Service.RequestEmailVerification(r.Context(), claims.ID); err != nil {
+		log.Error("failed to request email verification", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary ConfirmEmail
+// @ID confirmEmail
+// @Tags auth
+// @Description confirm email with the token from the verification link
+// @Accept json
+// @Produce json
+// @Param input body TokenRequest true "verification token"
+// @Success 200 {object}  api.Response
+// @Failure 400 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Router /verify-email/confirm [post]
+func (h *Handler) ConfirmEmailHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.ConfirmEmail"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	var req TokenRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	err := h.Service.ConfirmEmail(r.Context(), req.Token)
+	if err != nil {
+		log.Error("failed to confirm email", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary RequestPasswordReset
+// @ID requestPasswordReset
+// @Tags auth
+// @Description send a password reset link to the email if the account exists
+// @Accept json
+// @Produce json
+// @Param input body EmailRequest true "account email"
+// @Success 200 {object}  api.Response
+// @Failure 400 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Router /password-reset/request [post]
+func (h *Handler) RequestPasswordResetHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.RequestPasswordReset"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	var req EmailRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	if err := h.Service.RequestPasswordReset(r.Context(), req.Email); err != nil {
+		log.Error("failed to request password reset", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary ConfirmPasswordReset
+// @ID confirmPasswordReset
+// @Tags auth
+// @Description set a new password with the token from the reset link
+// @Accept json
+// @Produce json
+// @Param input body PasswordResetConfirmRequest true "reset token and new password"
+// @Success 200 {object}  api.Response
+// @Failure 400 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Router /password-reset/confirm [post]
+func (h *Handler) ConfirmPasswordResetHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.ConfirmPasswordReset"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	var req PasswordResetConfirmRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	err := h.Service.ConfirmPasswordReset(r.Context(), req.Token, req.Password)
+	if err != nil {
+		log.Error("failed to reset password", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary EnrollTwoFactor
+// @ID enrollTwoFactor
+// @Tags 2fa
+// @Description generate a new TOTP secret, it has to be confirmed with /2fa/enable
+// @Produce json
+// @Success 200 {object}  TwoFactorEnrollResponse
+// @Failure 401,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /2fa/enroll [post]
+func (h *Handler) EnrollTwoFactorHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.EnrollTwoFactor"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	secret, uri, err := h.Service.EnrollTwoFactor(r.Context(), claims.ID)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, TwoFactorEnrollResponse{
+		Response:        api.OK(),
+		Secret:          secret,
+		ProvisioningURI: uri,
+	})
+}
+
+// @Summary EnableTwoFactor
+// @ID enableTwoFactor
+// @Tags 2fa
+// @Description confirm the enrolled TOTP secret with a code and get recovery codes
+// @Accept json
+// @Produce json
+// @Param input body TwoFactorCodeRequest true "totp code"
+// @Success 200 {object}  TwoFactorEnableResponse
+// @Failure 400,401,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /2fa/enable [post]
+func (h *Handler) EnableTwoFactorHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.EnableTwoFactor"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req TwoFactorCodeRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	codes, err := h.Service.EnableTwoFactor(r.Context(), claims.ID, req.Code)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, TwoFactorEnableResponse{
+		Response:      api.OK(),
+		RecoveryCodes: codes,
+	})
+}
+
+// @Summary DisableTwoFactor
+// @ID disableTwoFactor
+// @Tags 2fa
+// @Description disable two-factor authentication with a TOTP or recovery code
+// @Accept json
+// @Produce json
+// @Param input body TwoFactorCodeRequest true "totp or recovery code"
+// @Success 200 {object}  api.Response
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /2fa/disable [post]
+func (h *Handler) DisableTwoFactorHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.DisableTwoFactor"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req TwoFactorCodeRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	if err := h.Service.DisableTwoFactor(r.Context(), claims.ID, req.Code); err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary TwoFactorLogin
+// @ID twoFactorLogin
+// @Tags 2fa
+// @Description finish login with the challenge token from /login and a TOTP or recovery code
+// @Accept json
+// @Produce json
+// @Param input body TwoFactorLoginRequest true "challenge and code"
+// @Success 200 {object}  LoginResponse
+// @Failure 400,401,429 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Router /2fa/login [post]
+func (h *Handler) TwoFactorLoginHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.TwoFactorLogin"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	var req TwoFactorLoginRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	user, err := h.Service.CompleteTwoFactorLogin(r.Context(), req.ChallengeToken, req.Code)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	if err := h.startSession(w, r, user.ID, true); err != nil {
+		log.Error("login cookie errors", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
 	render.JSON(w, r, LoginResponse{
@@ -140,3 +429,248 @@ func (h *Handler) LoginHandler(w http.ResponseWriter, r *http.Request) {
 		Username: user.Name,
 	})
 }
+
+// writeError отвечает доменной ошибкой, в лог пишутся только непредвиденные ошибки.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	if utils.AsError(err).Kind == utils.KindInternal {
+		log.Error("request failed", logger.Err(err))
+	}
+	api.WriteError(w, r, err)
+}
+
+// @Summary GetProfile
+// @ID getProfile
+// @Tags profile
+// @Description current user profile
+// @Produce json
+// @Success 200 {object}  ProfileResponse
+// @Failure 401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /me [get]
+func (h *Handler) GetProfileHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.GetProfile"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	user, err := h.Service.GetProfile(r.Context(), claims.ID)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, NewProfileResponse(user))
+}
+
+// @Summary UpdateProfile
+// @ID updateProfile
+// @Tags profile
+// @Description change username, email and/or notification language. version must match the current profile version
+// @Accept json
+// @Produce json
+// @Param input body UpdateProfileRequest true "profile changes"
+// @Success 200 {object}  ProfileResponse
+// @Failure 400,401,404,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /me [patch]
+func (h *Handler) UpdateProfileHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.UpdateProfile"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req UpdateProfileRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	user, err := h.Service.UpdateProfile(r.Context(), claims.ID, req.Version, req.Username, req.Email, req.Locale)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, NewProfileResponse(user))
+}
+
+// @Summary ChangePassword
+// @ID changePassword
+// @Tags profile
+// @Description change password, the current password is required. Every other session is revoked
+// @Accept json
+// @Produce json
+// @Param input body ChangePasswordRequest true "current and new password"
+// @Success 200 {object}  api.Response
+// @Failure 400,401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /me/password [post]
+func (h *Handler) ChangePasswordHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.ChangePassword"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req ChangePasswordRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	if err := h.Service.ChangePassword(r.Context(), claims.ID, claims.SessionID, req.CurrentPassword, req.NewPassword); err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary CloseAccount
+// @ID closeAccount
+// @Tags profile
+// @Description close the account. All wallets must be empty and no limit orders or payments may be pending, active schedules are cancelled
+// @Accept json
+// @Produce json
+// @Param input body CloseAccountRequest true "password confirmation"
+// @Success 200 {object}  api.Response
+// @Failure 400,401,404,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /me [delete]
+func (h *Handler) CloseAccountHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.CloseAccount"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req CloseAccountRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	if err := h.Service.CloseAccount(r.Context(), claims.ID, req.Password); err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	ClearCookieTokens(w, "localhost")
+	render.JSON(w, r, api.OK())
+}
+
+// @Summary ListSessions
+// @ID listSessions
+// @Tags sessions
+// @Description active sessions of the current user
+// @Produce json
+// @Success 200 {object}  SessionsResponse
+// @Failure 401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /sessions [get]
+func (h *Handler) ListSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.ListSessions"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	sessions, err := h.Service.ListSessions(r.Context(), claims.ID)
+	if err != nil {
+		log.Error("failed to list sessions", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	resp := SessionsResponse{
+		Response: api.OK(),
+		Sessions: make([]Session, 0, len(sessions)),
+	}
+	for _, s := range sessions {
+		resp.Sessions = append(resp.Sessions, Session{
+			ID:         s.ID,
+			Device:     s.Device,
+			IP:         s.IP,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			Current:    s.ID == claims.SessionID,
+		})
+	}
+	render.JSON(w, r, resp)
+}
+
+// @Summary RevokeSession
+// @ID revokeSession
+// @Tags sessions
+// @Description revoke a session, its tokens stop working immediately
+// @Produce json
+// @Param id path string true "session id"
+// @Success 200 {object}  api.Response
+// @Failure 400,401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /sessions/{id} [delete]
+func (h *Handler) RevokeSessionHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "User.Handler.RevokeSession"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := ClaimsFromContext(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidSessionID)
+		return
+	}
+	err = h.Service.RevokeSession(r.Context(), claims.ID, sessionID)
+	if err != nil {
+		log.Error("failed to revoke session", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	if sessionID == claims.SessionID {
+		ClearCookieTokens(w, "localhost")
+	}
+	render.JSON(w, r, api.OK())
+}
+
+func (h *Handler) startSession(w http.ResponseWriter, r *http.Request, userID uuid.UUID, notifyNewDevice bool) error {
+	sessionID, err := h.Service.StartSession(r.Context(), userID, SessionMetaFromRequest(r), notifyNewDevice)
+	if err != nil {
+		return err
+	}
+	return AddCookieTokens(userID, sessionID, w, "localhost")
+}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -8,26 +9,48 @@ import (
 	"os"
 	"time"
 
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
 type Claims struct {
-	ID uuid.UUID `json:"id"`
+	ID        uuid.UUID `json:"id"`
+	SessionID uuid.UUID `json:"sid,omitempty"`
+	Purpose   string    `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJwtToken(id uuid.UUID, expire time.Time) (string, error) {
+const (
+	AccessTokenTTL  = 4 * time.Hour
+	RefreshTokenTTL = 14 * 24 * time.Hour
+)
+
+const (
+	ChallengePurposeTwoFactor = "2fa_challenge"
+	ChallengeTTL              = 5 * time.Minute
+	// ChallengeMaxFailures — после стольких неверных кодов challenge токен гасится и вход начинается заново
+	ChallengeMaxFailures = 5
+)
+
+func ClaimsFromContext(ctx context.Context) (*Claims, error) {
+	claims, ok := ctx.Value(contextkey.UserIDCtxKey).(*Claims)
+	if !ok {
+		return nil, errors.New("no JWT claims found in context")
+	}
+	return claims, nil
+}
+
+func GenerateJwtToken(id, sessionID uuid.UUID, expire time.Time) (string, error) {
 	claim := &Claims{
-		ID: id,
+		ID:        id,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expire),
 		},
 	}
 
-	secretKey := []byte(os.Getenv("JWT_SECRET"))
-	tokens := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
-	tokenString, err := tokens.SignedString(secretKey)
+	tokenString, err := signToken(claim)
 
 	if err != nil {
 		slog.Error("GenerateJwtToken err:", slog.Any("err", err))
@@ -36,14 +59,71 @@ func GenerateJwtToken(id uuid.UUID, expire time.Time) (string, error) {
 	return tokenString, nil
 }
 
-func ParseToken(tokenString string) (*Claims, error) {
+// GenerateChallengeToken выдаёт короткоживущий токен, который подтверждает,
+// что пароль уже проверен и осталось ввести код второго фактора. jti токена —
+// ключ счётчика неверных кодов, см. ChallengeMaxFailures.
+func GenerateChallengeToken(id uuid.UUID) (string, error) {
+	claim := &Claims{
+		ID:      id,
+		Purpose: ChallengePurposeTwoFactor,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ChallengeTTL)),
+		},
+	}
+	return signToken(claim)
+}
+
+// signToken подписывает токен действующим ключом из KeyStore, без него — JWT_SECRET.
+func signToken(claim *Claims) (string, error) {
+	kid, secret := "", envSecret()
+	if store := keyStore.Load(); store != nil {
+		kid, secret = store.signingKey(time.Now().UTC())
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	return token.SignedString(secret)
+}
 
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
+// verificationKey выбирает секрет по заголовку kid токена.
+func verificationKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, errors.New("unexpected signing method")
+	}
+	kid, _ := token.Header["kid"].(string)
+	store := keyStore.Load()
+	if store == nil {
+		if kid != "" {
+			return nil, errors.New("unknown signing key")
 		}
-		return []byte(os.Getenv("JWT_SECRET")), nil
-	})
+		return envSecret(), nil
+	}
+	secret, ok := store.verificationKey(kid, time.Now().UTC())
+	if !ok {
+		return nil, errors.New("unknown or expired signing key")
+	}
+	return secret, nil
+}
+
+func ParseChallengeToken(tokenString string) (*Claims, error) {
+	claims, err := ParseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != ChallengePurposeTwoFactor {
+		return nil, errors.New("invalid token purpose")
+	}
+	if claims.RegisteredClaims.ID == "" || claims.ExpiresAt == nil {
+		return nil, errors.New("challenge token without id")
+	}
+	return claims, nil
+}
+
+func ParseToken(tokenString string) (*Claims, error) {
+
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, verificationKey)
 
 	if err != nil {
 		return nil, err
@@ -56,14 +136,14 @@ func ParseToken(tokenString string) (*Claims, error) {
 	return nil, errors.New("invalid token")
 
 }
-func AddCookieTokens(id uuid.UUID, w http.ResponseWriter, domain string) error {
-	expirationTimeAccess := time.Now().Add(4 * time.Hour)
-	expirationTimeRefresh := time.Now().Add(14 * 24 * time.Hour)
-	refreshToken, err := GenerateJwtToken(id, expirationTimeRefresh)
+func AddCookieTokens(id, sessionID uuid.UUID, w http.ResponseWriter, domain string) error {
+	expirationTimeAccess := time.Now().Add(AccessTokenTTL)
+	expirationTimeRefresh := time.Now().Add(RefreshTokenTTL)
+	refreshToken, err := GenerateJwtToken(id, sessionID, expirationTimeRefresh)
 	if err != nil {
 		return err
 	}
-	accessToken, err := GenerateJwtToken(id, expirationTimeAccess)
+	accessToken, err := GenerateJwtToken(id, sessionID, expirationTimeAccess)
 	if err != nil {
 		return err
 	}
@@ -72,6 +152,12 @@ func AddCookieTokens(id uuid.UUID, w http.ResponseWriter, domain string) error {
 
 	return nil
 }
+func ClearCookieTokens(w http.ResponseWriter, domain string) {
+	expired := time.Unix(0, 0)
+	http.SetCookie(w, GenerateCookie("accessToken", expired, false, "", domain))
+	http.SetCookie(w, GenerateCookie("refreshToken", expired, true, "", domain))
+}
+
 func NewAccessToken(tokenString string, threshold time.Duration, w http.ResponseWriter, domain string) (string, error) {
 	claims, err := ParseToken(tokenString)
 	if err != nil {
@@ -83,8 +169,8 @@ func NewAccessToken(tokenString string, threshold time.Duration, w http.Response
 		return tokenString, nil
 	}
 
-	newExpire := time.Now().Add(4 * time.Hour)
-	newToken, err := GenerateJwtToken(claims.ID, newExpire)
+	newExpire := time.Now().Add(AccessTokenTTL)
+	newToken, err := GenerateJwtToken(claims.ID, claims.SessionID, newExpire)
 	if err != nil {
 		return "", err
 	}
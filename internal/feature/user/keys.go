@@ -0,0 +1,208 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+)
+
+const (
+	// KeyRefreshInterval — как часто экземпляры сервиса перечитывают ключи подписи из БД.
+	KeyRefreshInterval = time.Minute
+	// KeyRotationDelay — через сколько после ротации новый ключ начинает подписывать токены:
+	// за это время его успевают подхватить все экземпляры.
+	KeyRotationDelay = 2 * KeyRefreshInterval
+)
+
+// SigningKey — расшифрованный ключ подписи. Kid пишется в заголовок токена, по нему выбирается ключ проверки.
+type SigningKey struct {
+	Kid         string
+	Secret      []byte
+	ActivatesAt time.Time
+	RetiredAt   *time.Time
+	CreatedAt   time.Time
+}
+
+// signs — подписывает ли ключ новые токены в момент now.
+func (k SigningKey) signs(now time.Time) bool {
+	return !now.Before(k.ActivatesAt) && (k.RetiredAt == nil || now.Before(*k.RetiredAt))
+}
+
+// verifies — принимаются ли подписанные ключом токены в момент now. Выведенный ключ проверяет
+// токены ещё RefreshTokenTTL, пока не истекут все выданные им refresh-токены.
+func (k SigningKey) verifies(now time.Time) bool {
+	return k.RetiredAt == nil || now.Before(k.RetiredAt.Add(RefreshTokenTTL))
+}
+
+// State описывает ключ для оператора: pending, active, verify-only или expired.
+func (k SigningKey) State(now time.Time) string {
+	switch {
+	case now.Before(k.ActivatesAt):
+		return "pending"
+	case k.signs(now):
+		return "active"
+	case k.verifies(now):
+		return "verify-only"
+	default:
+		return "expired"
+	}
+}
+
+type KeyRepository interface {
+	ListSigningKeys(ctx context.Context) ([]*SigningKeyDB, error)
+	RotateSigningKey(ctx context.Context, key *SigningKeyDB) error
+}
+
+// KeyStore хранит ключи подписи JWT в БД зашифрованными и держит их копию в памяти.
+// Пока в БД нет ни одного ключа, токены подписываются JWT_SECRET без kid.
+type KeyStore struct {
+	repo KeyRepository
+	box  *secretbox.Box
+
+	mu   sync.RWMutex
+	keys []SigningKey
+}
+
+func NewKeyStore(repo KeyRepository, box *secretbox.Box) *KeyStore {
+	return &KeyStore{repo: repo, box: box}
+}
+
+// keyStore — хранилище, которым пользуются GenerateJwtToken и ParseToken. Без него работает только JWT_SECRET.
+var keyStore atomic.Pointer[KeyStore]
+
+// UseKeyStore подключает хранилище ключей к выпуску и проверке токенов.
+func UseKeyStore(k *KeyStore) {
+	keyStore.Store(k)
+}
+
+// Load перечитывает ключи из БД.
+func (k *KeyStore) Load(ctx context.Context) error {
+	rows, err := k.repo.ListSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]SigningKey, 0, len(rows))
+	for _, row := range rows {
+		secret, err := k.box.Open(row.SecretEncrypted)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, SigningKey{
+			Kid:         row.Kid,
+			Secret:      secret,
+			ActivatesAt: row.ActivatesAt,
+			RetiredAt:   row.RetiredAt,
+			CreatedAt:   row.CreatedAt,
+		})
+	}
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+	return nil
+}
+
+// StartRefresh по тикеру перечитывает ключи, чтобы ротация дошла до всех экземпляров.
+func (k *KeyStore) StartRefresh(ctx context.Context, handlePeriod time.Duration, log *slog.Logger) {
+	const op = "User.KeyStore.StartRefresh"
+
+	log = log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping signing key refresh")
+				return
+
+			case <-ticker.C:
+				if err := k.Load(ctx); err != nil {
+					log.Error("failed to refresh signing keys", logger.Err(err))
+				}
+			}
+		}
+	}()
+}
+
+// Rotate создаёт новый ключ, который начнёт подписывать токены через delay. Действующий ключ
+// в тот же момент выводится из оборота, но ещё проверяет выданные им токены.
+func (k *KeyStore) Rotate(ctx context.Context, delay time.Duration) (*SigningKey, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	kidRaw := make([]byte, 8)
+	if _, err := rand.Read(kidRaw); err != nil {
+		return nil, err
+	}
+	encrypted, err := k.box.Seal(secret)
+	if err != nil {
+		return nil, err
+	}
+	row := &SigningKeyDB{
+		Kid:             hex.EncodeToString(kidRaw),
+		SecretEncrypted: encrypted,
+		ActivatesAt:     time.Now().UTC().Add(delay),
+	}
+	if err := k.repo.RotateSigningKey(ctx, row); err != nil {
+		return nil, err
+	}
+	if err := k.Load(ctx); err != nil {
+		return nil, err
+	}
+	return &SigningKey{Kid: row.Kid, ActivatesAt: row.ActivatesAt}, nil
+}
+
+// Keys возвращает копию загруженных ключей, новые первыми.
+func (k *KeyStore) Keys() []SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return append([]SigningKey(nil), k.keys...)
+}
+
+// signingKey выбирает ключ, которым подписываются токены в момент now. Пустой kid — JWT_SECRET.
+func (k *KeyStore) signingKey(now time.Time) (string, []byte) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	for _, key := range k.keys {
+		if key.signs(now) {
+			return key.Kid, key.Secret
+		}
+	}
+	return "", envSecret()
+}
+
+// verificationKey возвращает секрет для проверки токена с заголовком kid.
+// JWT_SECRET считается самым первым ключом: его вывел из оборота первый ключ из БД.
+func (k *KeyStore) verificationKey(kid string, now time.Time) ([]byte, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if kid == "" {
+		if len(k.keys) == 0 {
+			return envSecret(), true
+		}
+		first := k.keys[len(k.keys)-1]
+		env := SigningKey{RetiredAt: &first.ActivatesAt}
+		return envSecret(), env.verifies(now)
+	}
+	for _, key := range k.keys {
+		if key.Kid == kid {
+			return key.Secret, key.verifies(now)
+		}
+	}
+	return nil, false
+}
+
+func envSecret() []byte {
+	return []byte(os.Getenv("JWT_SECRET"))
+}
@@ -3,41 +3,49 @@ package user
 import (
 	"context"
 	"errors"
-	"fmt"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
 	"github.com/Sanchir01/currency-wallet/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
 type Repository struct {
 	primaryDB *pgxpool.Pool
+	reads     *db.Router
 }
 
-func NewRepository(primaryDB *pgxpool.Pool) *Repository {
-	return &Repository{primaryDB: primaryDB}
+// NewRepository — reads направляет часть чтений на реплики, без него все запросы идут в primaryDB.
+func NewRepository(primaryDB *pgxpool.Pool, reads *db.Router) *Repository {
+	return &Repository{primaryDB: primaryDB, reads: reads}
 }
 
-func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*DatabaseUser, error) {
-	conn, err := r.primaryDB.Acquire(ctx)
-	if err != nil {
-		return nil, err
+// reader — через что читать: транзакция из ctx, реплика или основная база.
+func (r *Repository) reader(ctx context.Context) db.Querier {
+	if r.reads == nil {
+		return db.Conn(ctx, r.primaryDB)
 	}
-	defer conn.Release()
+	return r.reads.Reader(ctx)
+}
+
+func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*DatabaseUser, error) {
+	conn := r.reader(ctx)
 
 	query, arg, err := sq.
-		Select("id, email,username, version,password").
+		Select("id, email,username, version,password,email_verified_at,locale").
 		From("public.users").
-		Where(sq.Eq{"email": email}).
+		Where(sq.Expr("lower(email) = lower(?)", email)).
+		Where(sq.Eq{"closed_at": nil}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 	var userDB DatabaseUser
-	if err := conn.QueryRow(ctx, query, arg...).Scan(&userDB.ID, &userDB.Email, &userDB.Name, &userDB.Version, &userDB.Password); err != nil {
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&userDB.ID, &userDB.Email, &userDB.Name, &userDB.Version, &userDB.Password, &userDB.EmailVerifiedAt, &userDB.Locale); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, utils.ErrorUserNotFound
 		}
@@ -46,7 +54,8 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*Databas
 	return &userDB, nil
 }
 
-func (r *Repository) CreateUser(ctx context.Context, email, username string, password []byte, tx pgx.Tx) (*uuid.UUID, error) {
+func (r *Repository) CreateUser(ctx context.Context, email, username string, password []byte) (*uuid.UUID, error) {
+	conn := db.Conn(ctx, r.primaryDB)
 	query, arg, err := sq.
 		Insert("users").
 		Columns("email", "password", "username").
@@ -59,11 +68,11 @@ func (r *Repository) CreateUser(ctx context.Context, email, username string, pas
 	}
 	var id uuid.UUID
 
-	if err := tx.QueryRow(ctx, query, arg...).Scan(&id); err != nil {
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&id); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			if pgErr.Code == "23505" {
-				return nil, fmt.Errorf("пользователь с таким email или username уже существует")
+				return nil, utils.ErrorUserAlreadyExists
 			}
 		}
 
@@ -73,14 +82,34 @@ func (r *Repository) CreateUser(ctx context.Context, email, username string, pas
 }
 
 func (r *Repository) GetUserByID(ctx context.Context, id uuid.UUID) (*DatabaseUser, error) {
-	conn, err := r.primaryDB.Acquire(ctx)
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select("id, email,username, version,password,email_verified_at,created_at,updated_at,locale").
+		From("public.users").
+		Where(sq.Eq{"id": id, "closed_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Release()
+	var userDB DatabaseUser
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&userDB.ID, &userDB.Email, &userDB.Name, &userDB.Version,
+		&userDB.Password, &userDB.EmailVerifiedAt, &userDB.CreatedAt, &userDB.UpdatedAt, &userDB.Locale); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorUserNotFound
+		}
+		return nil, err
+	}
+	return &userDB, nil
+}
+
+// GetLocale возвращает язык пользователя. В отличие от GetUserByID закрытые аккаунты не отфильтровываются.
+func (r *Repository) GetLocale(ctx context.Context, id uuid.UUID) (*string, error) {
+	conn := db.Conn(ctx, r.primaryDB)
 
 	query, arg, err := sq.
-		Select("id, email,coin, version").
+		Select("locale").
 		From("public.users").
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
@@ -88,9 +117,640 @@ func (r *Repository) GetUserByID(ctx context.Context, id uuid.UUID) (*DatabaseUs
 	if err != nil {
 		return nil, err
 	}
+	var locale *string
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&locale); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorUserNotFound
+		}
+		return nil, err
+	}
+	return locale, nil
+}
+
+func (r *Repository) CreateToken(ctx context.Context, userID uuid.UUID, purpose TokenPurpose, tokenHash []byte, expiresAt time.Time) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Insert("user_tokens").
+		Columns("user_id", "purpose", "token_hash", "expires_at").
+		Values(userID, purpose, tokenHash, expiresAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UseToken помечает токен использованным и возвращает id владельца.
+// Просроченные и уже использованные токены не принимаются.
+func (r *Repository) UseToken(ctx context.Context, purpose TokenPurpose, tokenHash []byte) (uuid.UUID, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("user_tokens").
+		Set("used_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"token_hash": tokenHash, "purpose": purpose, "used_at": nil}).
+		Where(sq.Expr("expires_at > CURRENT_TIMESTAMP")).
+		Suffix("RETURNING user_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, utils.ErrorQueryString
+	}
+	var userID uuid.UUID
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, utils.ErrorInvalidToken
+		}
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
+
+// InvalidateTokens погашает все неиспользованные токены пользователя с данным назначением.
+func (r *Repository) InvalidateTokens(ctx context.Context, userID uuid.UUID, purpose TokenPurpose) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("user_tokens").
+		Set("used_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "purpose": purpose, "used_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) SetEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("users").
+		Set("email_verified_at", sq.Expr("COALESCE(email_verified_at, CURRENT_TIMESTAMP)")).
+		Where(sq.Eq{"id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) UpdatePassword(ctx context.Context, userID uuid.UUID, password []byte) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("users").
+		Set("password", password).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetRole(ctx context.Context, userID uuid.UUID) (Role, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select("role").
+		From("public.users").
+		Where(sq.Eq{"id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", utils.ErrorQueryString
+	}
+	var role Role
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", utils.ErrorUserNotFound
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+func (r *Repository) IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select("email_verified_at IS NOT NULL").
+		From("public.users").
+		Where(sq.Eq{"id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, utils.ErrorQueryString
+	}
+	var verified bool
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&verified); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, utils.ErrorUserNotFound
+		}
+		return false, err
+	}
+	return verified, nil
+}
+
+// SaveTOTPSecret сохраняет новый (ещё не подтверждённый) секрет. Уже включённый 2FA не перезаписывается.
+func (r *Repository) SaveTOTPSecret(ctx context.Context, userID uuid.UUID, secretEncrypted []byte) error {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Insert("user_totp").
+		Columns("user_id", "secret_encrypted").
+		Values(userID, secretEncrypted).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE
+			SET secret_encrypted = EXCLUDED.secret_encrypted, last_used_step = 0
+			WHERE user_totp.enabled_at IS NULL`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorTwoFactorAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *Repository) GetTOTP(ctx context.Context, userID uuid.UUID) (*TOTPDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select("user_id, secret_encrypted, last_used_step, enabled_at").
+		From("user_totp").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	var totpDB TOTPDB
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&totpDB.UserID, &totpDB.SecretEncrypted, &totpDB.LastUsedStep, &totpDB.EnabledAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorTwoFactorNotEnrolled
+		}
+		return nil, err
+	}
+	return &totpDB, nil
+}
+
+// ConsumeTOTPStep атомарно запоминает использованный интервал, чтобы один и тот же код нельзя было применить повторно.
+func (r *Repository) ConsumeTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Update("user_totp").
+		Set("last_used_step", step).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Lt{"last_used_step": step}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (r *Repository) EnableTOTP(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("user_totp").
+		Set("enabled_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "enabled_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorTwoFactorAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *Repository) DeleteTOTP(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Delete("user_totp").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return r.deleteRecoveryCodes(ctx, userID)
+}
+
+// ReplaceRecoveryCodes удаляет старые коды восстановления и сохраняет хеши новых.
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes [][]byte) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	if err := r.deleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	builder := sq.Insert("user_recovery_codes").
+		Columns("user_id", "code_hash").
+		PlaceholderFormat(sq.Dollar)
+	for _, hash := range codeHashes {
+		builder = builder.Values(userID, hash)
+	}
+	query, arg, err := builder.ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) error {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Update("user_recovery_codes").
+		Set("used_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "code_hash": codeHash, "used_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (r *Repository) deleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Delete("user_recovery_codes").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateProfile меняет username, email и/или язык только если version совпадает с текущей,
+// иначе возвращает utils.ErrorVersionConflict. При смене email подтверждение сбрасывается.
+func (r *Repository) UpdateProfile(ctx context.Context, id uuid.UUID, version int64, username, email, locale *string) (*DatabaseUser, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.
+		Update("users").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "version": version, "closed_at": nil}).
+		Suffix("RETURNING id, email, username, version, email_verified_at, created_at, updated_at, locale").
+		PlaceholderFormat(sq.Dollar)
+	if username != nil {
+		builder = builder.Set("username", *username)
+	}
+	if locale != nil {
+		builder = builder.Set("locale", *locale)
+	}
+	if email != nil {
+		builder = builder.
+			Set("email_verified_at", sq.Expr("CASE WHEN lower(email) = lower(?) THEN email_verified_at END", *email)).
+			Set("email", *email)
+	}
+	query, arg, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
 	var userDB DatabaseUser
-	if err := conn.QueryRow(ctx, query, arg...).Scan(&userDB.ID, &userDB.Email, &userDB.Version); err != nil {
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&userDB.ID, &userDB.Email, &userDB.Name, &userDB.Version,
+		&userDB.EmailVerifiedAt, &userDB.CreatedAt, &userDB.UpdatedAt, &userDB.Locale); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorVersionConflict
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, utils.ErrorUserAlreadyExists
+		}
 		return nil, err
 	}
 	return &userDB, nil
 }
+
+func (r *Repository) CloseAccount(ctx context.Context, id uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("users").
+		Set("closed_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "closed_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorUserNotFound
+	}
+	return nil
+}
+
+// HasOpenOrders сообщает, есть ли у пользователя открытые лимитные ордера.
+func (r *Repository) HasOpenOrders(ctx context.Context, userID uuid.UUID) (bool, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Select("1").
+		From("limit_orders").
+		Where(sq.Eq{"user_id": userID, "status": "OPEN"}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, utils.ErrorQueryString
+	}
+	var exists bool
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// HasPendingPayments сообщает, есть ли у пользователя пополнения или выплаты, которые ещё не закрыл провайдер.
+func (r *Repository) HasPendingPayments(ctx context.Context, userID uuid.UUID) (bool, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Select("1").
+		From("payments").
+		Where(sq.Eq{"user_id": userID, "status": "PENDING"}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, utils.ErrorQueryString
+	}
+	var exists bool
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// CancelSchedules отменяет активные и приостановленные расписания пользователя.
+func (r *Repository) CancelSchedules(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("schedules").
+		Set("status", "CANCELLED").
+		Set("next_run_at", nil).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "status": []string{"ACTIVE", "PAUSED"}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, arg...)
+	return err
+}
+
+func (r *Repository) CreateSession(ctx context.Context, userID uuid.UUID, meta SessionMeta, expiresAt time.Time) (uuid.UUID, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Insert("sessions").
+		Columns("user_id", "device", "ip", "user_agent", "expires_at").
+		Values(userID, meta.Device, meta.IP, meta.UserAgent, expiresAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, utils.ErrorQueryString
+	}
+	var id uuid.UUID
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&id); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// KnownDevice сообщает, входил ли пользователь раньше с таким же User-Agent.
+func (r *Repository) KnownDevice(ctx context.Context, userID uuid.UUID, userAgent string) (bool, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Select("1").
+		From("sessions").
+		Where(sq.Eq{"user_id": userID, "user_agent": userAgent}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, utils.ErrorQueryString
+	}
+	var known bool
+	if err := conn.QueryRow(ctx, query, arg...).Scan(&known); err != nil {
+		return false, err
+	}
+	return known, nil
+}
+
+func (r *Repository) ListSessions(ctx context.Context, userID uuid.UUID) ([]*SessionDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Select("id, user_id, device, ip, user_agent, created_at, last_seen_at, expires_at, revoked_at").
+		From("sessions").
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil}).
+		Where(sq.Expr("expires_at > CURRENT_TIMESTAMP")).
+		OrderBy("last_seen_at DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	sessions := make([]*SessionDB, 0)
+	for rows.Next() {
+		var s SessionDB
+		if err := rows.Scan(&s.ID, &s.UserID, &s.Device, &s.IP, &s.UserAgent, &s.CreatedAt, &s.LastSeenAt, &s.ExpiresAt, &s.RevokedAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// TouchSession обновляет время последней активности и возвращает false для отозванной или истёкшей сессии.
+func (r *Repository) TouchSession(ctx context.Context, sessionID, userID uuid.UUID, ip string) (bool, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Update("sessions").
+		Set("last_seen_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("ip", ip).
+		Where(sq.Eq{"id": sessionID, "user_id": userID, "revoked_at": nil}).
+		Where(sq.Expr("expires_at > CURRENT_TIMESTAMP")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *Repository) RevokeSession(ctx context.Context, sessionID, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+
+	query, arg, err := sq.
+		Update("sessions").
+		Set("revoked_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": sessionID, "user_id": userID, "revoked_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, arg...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorSessionNotFound
+	}
+	return nil
+}
+
+func (r *Repository) RevokeAllSessions(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("sessions").
+		Set("revoked_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RevokeOtherSessions отзывает все активные сессии пользователя, кроме keepID.
+func (r *Repository) RevokeOtherSessions(ctx context.Context, userID, keepID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Update("sessions").
+		Set("revoked_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil}).
+		Where(sq.NotEq{"id": keepID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	if _, err := conn.Exec(ctx, query, arg...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListSigningKeys возвращает все ключи подписи JWT, новые первыми.
+func (r *Repository) ListSigningKeys(ctx context.Context) ([]*SigningKeyDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, arg, err := sq.
+		Select("kid, secret_encrypted, activates_at, retired_at, created_at").
+		From("jwt_keys").
+		OrderBy("activates_at DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, arg...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	keys := make([]*SigningKeyDB, 0)
+	for rows.Next() {
+		var k SigningKeyDB
+		if err := rows.Scan(&k.Kid, &k.SecretEncrypted, &k.ActivatesAt, &k.RetiredAt, &k.CreatedAt); err != nil {
+			return nil, err
+		}
+		keys = append(keys, &k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RotateSigningKey добавляет ключ и одним запросом выводит из оборота действующие: они перестают подписывать
+// в момент активации нового.
+func (r *Repository) RotateSigningKey(ctx context.Context, key *SigningKeyDB) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	_, err := conn.Exec(ctx, `
+		WITH retired AS (
+			UPDATE jwt_keys SET retired_at = $3 WHERE retired_at IS NULL
+		)
+		INSERT INTO jwt_keys (kid, secret_encrypted, activates_at) VALUES ($1, $2, $3)`,
+		key.Kid, key.SecretEncrypted, key.ActivatesAt)
+	return err
+}
@@ -2,99 +2,866 @@ package user
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/mailer"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+	"github.com/Sanchir01/currency-wallet/pkg/totp"
 	"github.com/Sanchir01/currency-wallet/pkg/utils"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"log/slog"
+	"strings"
+	"time"
 )
 
 type Service struct {
 	repository    ServiceUser
 	walletservice ServiceWallet
 	log           *slog.Logger
-	primaryDB     *pgxpool.Pool
+	tx            *db.TxManager
+	mailer        mailer.Mailer
+	linkBaseURL   string
+	twoFactor     TwoFactorOptions
+	lockout       LoginLockout
+	events        ServiceEvents
+	audit         ServiceAudit
+}
+
+type ServiceEvents interface {
+	CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error)
+}
+
+type ServiceAudit interface {
+	Record(ctx context.Context, e audit.Entry) error
+}
+
+type LoginLockout interface {
+	LockedFor(ctx context.Context, account string) (time.Duration, error)
+	RegisterFailure(ctx context.Context, account string) (time.Duration, error)
+	Reset(ctx context.Context, account string) error
+	RegisterChallengeFailure(ctx context.Context, challengeID string, ttl time.Duration) (int64, error)
+	ChallengeFailures(ctx context.Context, challengeID string) (int64, error)
+}
+
+type TwoFactorOptions struct {
+	Box    *secretbox.Box
+	Issuer string
+	// StepUpThresholds — суммы по валютам, начиная с которых операция требует код второго фактора
+	StepUpThresholds map[string]float32
+}
+
+// stepUp сообщает, нужен ли код второго фактора для операции на amount в currency.
+// Без порогов проверка выключена, валюта без своего порога требует код на любую сумму.
+func (o TwoFactorOptions) stepUp(amount float32, currency string) bool {
+	if len(o.StepUpThresholds) == 0 {
+		return false
+	}
+	threshold, ok := o.StepUpThresholds[currency]
+	return !ok || amount >= threshold
 }
 
 type ServiceWallet interface {
-	CreateManyWallets(ctx context.Context, userID uuid.UUID, tx pgx.Tx) error
+	CreateManyWallets(ctx context.Context, userID uuid.UUID) error
+	Balance(ctx context.Context, id uuid.UUID) (*models.CurrencyWallet, error)
 }
 type ServiceUser interface {
-	CreateUser(ctx context.Context, email, username string, password []byte, tx pgx.Tx) (*uuid.UUID, error)
+	CreateUser(ctx context.Context, email, username string, password []byte) (*uuid.UUID, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*DatabaseUser, error)
+	GetLocale(ctx context.Context, id uuid.UUID) (*string, error)
 	GetUserByEmail(ctx context.Context, email string) (*DatabaseUser, error)
+	CreateToken(ctx context.Context, userID uuid.UUID, purpose TokenPurpose, tokenHash []byte, expiresAt time.Time) error
+	UseToken(ctx context.Context, purpose TokenPurpose, tokenHash []byte) (uuid.UUID, error)
+	InvalidateTokens(ctx context.Context, userID uuid.UUID, purpose TokenPurpose) error
+	SetEmailVerified(ctx context.Context, userID uuid.UUID) error
+	UpdatePassword(ctx context.Context, userID uuid.UUID, password []byte) error
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	GetRole(ctx context.Context, userID uuid.UUID) (Role, error)
+	SaveTOTPSecret(ctx context.Context, userID uuid.UUID, secretEncrypted []byte) error
+	GetTOTP(ctx context.Context, userID uuid.UUID) (*TOTPDB, error)
+	ConsumeTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+	EnableTOTP(ctx context.Context, userID uuid.UUID) error
+	DeleteTOTP(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes [][]byte) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) error
+	UpdateProfile(ctx context.Context, id uuid.UUID, version int64, username, email, locale *string) (*DatabaseUser, error)
+	CloseAccount(ctx context.Context, id uuid.UUID) error
+	HasOpenOrders(ctx context.Context, userID uuid.UUID) (bool, error)
+	HasPendingPayments(ctx context.Context, userID uuid.UUID) (bool, error)
+	CancelSchedules(ctx context.Context, userID uuid.UUID) error
+	CreateSession(ctx context.Context, userID uuid.UUID, meta SessionMeta, expiresAt time.Time) (uuid.UUID, error)
+	KnownDevice(ctx context.Context, userID uuid.UUID, userAgent string) (bool, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*SessionDB, error)
+	TouchSession(ctx context.Context, sessionID, userID uuid.UUID, ip string) (bool, error)
+	RevokeSession(ctx context.Context, sessionID, userID uuid.UUID) error
+	RevokeAllSessions(ctx context.Context, userID uuid.UUID) error
+	RevokeOtherSessions(ctx context.Context, userID, keepID uuid.UUID) error
 }
 
-func NewService(r ServiceUser, walletservice ServiceWallet, db *pgxpool.Pool, l *slog.Logger) *Service {
+func NewService(
+	r ServiceUser,
+	walletservice ServiceWallet,
+	tx *db.TxManager,
+	m mailer.Mailer,
+	linkBaseURL string,
+	twoFactor TwoFactorOptions,
+	lockout LoginLockout,
+	events ServiceEvents,
+	audit ServiceAudit,
+	l *slog.Logger,
+) *Service {
 	return &Service{
 		repository:    r,
-		primaryDB:     db,
+		tx:            tx,
 		log:           l,
 		walletservice: walletservice,
+		mailer:        m,
+		linkBaseURL:   linkBaseURL,
+		twoFactor:     twoFactor,
+		lockout:       lockout,
+		events:        events,
+		audit:         audit,
 	}
 }
 
 func (s *Service) Register(ctx context.Context, email, username, password string) (*uuid.UUID, error) {
 	const op = "User.Service.Register"
 	log := s.log.With(slog.String("op", op))
-	conn, err := s.primaryDB.Acquire(ctx)
+	hashedPassword, err := GeneratePasswordHash(password)
 	if err != nil {
+		log.Error("error generating password hash", slog.String("error", err.Error()))
+		return nil, err
+	}
+	var (
+		user  *uuid.UUID
+		token string
+	)
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		user, err = s.repository.CreateUser(ctx, email, username, hashedPassword)
+		if err != nil {
+			log.Error("error creating user", slog.String("error", err.Error()))
+			return err
+		}
+		if err := s.walletservice.CreateManyWallets(ctx, *user); err != nil {
+			log.Error("error creating wallets", slog.String("error", err.Error()))
+			return err
+		}
+		token, err = s.issueToken(ctx, *user, TokenPurposeEmailVerification, EmailVerificationTTL)
+		if err != nil {
+			log.Error("error creating verification token", slog.String("error", err.Error()))
+			return err
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionRegister,
+			ActorID:  user,
+			TargetID: user,
+			After:    map[string]string{"email": email, "username": username},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.sendVerificationEmail(ctx, i18n.FromContext(ctx), email, token)
+	log.Info("user created success", slog.String("user_id", user.String()))
+	return user, nil
+}
 
+func (s *Service) Login(ctx context.Context, email, password string) (*DatabaseUser, error) {
+	const op = "User.Service.Login"
+	log := s.log.With(slog.String("op", op))
+	lockedFor, err := s.lockout.LockedFor(ctx, email)
+	if err != nil {
+		log.Error("error checking login lockout", slog.String("error", err.Error()))
+	}
+	if lockedFor > 0 {
+		return nil, &utils.RetryAfterError{Err: utils.ErrorAccountLocked, RetryAfter: lockedFor}
+	}
+	user, err := s.repository.GetUserByEmail(ctx, email)
+	if errors.Is(err, utils.ErrorUserNotFound) {
+		s.registerLoginFailure(ctx, email)
 		return nil, err
 	}
-	defer conn.Release()
-	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		log.Error("tx error", err.Error())
+		log.Error("error getting user by email", slog.String("error", err.Error()))
 		return nil, err
 	}
+	ok := VerifyPassword(user.Password, password)
+	if !ok {
+		log.Error("invalid password")
+		if lock := s.registerLoginFailure(ctx, email); lock > 0 {
+			return nil, &utils.RetryAfterError{Err: utils.ErrorAccountLocked, RetryAfter: lock}
+		}
+		return nil, utils.ErrorInvalidPassword
+	}
+	if err := s.lockout.Reset(ctx, email); err != nil {
+		log.Error("error resetting login failures", slog.String("error", err.Error()))
+	}
+	user.TwoFactorEnabled, err = s.TwoFactorEnabled(ctx, user.ID)
+	if err != nil {
+		log.Error("error checking two-factor status", slog.String("error", err.Error()))
+		return nil, err
+	}
+	log.Info("user service logged in user")
+	return user, nil
+}
 
-	defer func() {
+// RequestEmailVerification выпускает новый токен подтверждения email.
+// Ранее выданные неиспользованные токены при этом погашаются.
+func (s *Service) RequestEmailVerification(ctx context.Context, userID uuid.UUID) (err error) {
+	const op = "User.Service.RequestEmailVerification"
+	log := s.log.With(slog.String("op", op))
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return err
+	}
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+	var token string
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if err = s.repository.InvalidateTokens(ctx, userID, TokenPurposeEmailVerification); err != nil {
+			log.Error("error invalidating tokens", slog.String("error", err.Error()))
+			return err
+		}
+		token, err = s.issueToken(ctx, userID, TokenPurposeEmailVerification, EmailVerificationTTL)
 		if err != nil {
-			rollbackErr := tx.Rollback(ctx)
-			if rollbackErr != nil {
-				err = errors.Join(err, rollbackErr)
-				log.Error("rollback error", rollbackErr.Error())
-				return
-			}
+			log.Error("error creating verification token", slog.String("error", err.Error()))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.sendVerificationEmail(ctx, userLocale(ctx, user.Locale), user.Email, token)
+	return nil
+}
+
+func (s *Service) ConfirmEmail(ctx context.Context, token string) (err error) {
+	const op = "User.Service.ConfirmEmail"
+	log := s.log.With(slog.String("op", op))
+	var userID uuid.UUID
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		userID, err = s.repository.UseToken(ctx, TokenPurposeEmailVerification, HashToken(token))
+		if err != nil {
+			log.Error("error using verification token", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.SetEmailVerified(ctx, userID); err != nil {
+			log.Error("error setting email verified", slog.String("error", err.Error()))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("email verified", slog.String("user_id", userID.String()))
+	return nil
+}
+
+// RequestPasswordReset отправляет письмо со ссылкой на сброс пароля.
+// Для неизвестного email ошибка не возвращается, чтобы не раскрывать наличие аккаунта.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) (err error) {
+	const op = "User.Service.RequestPasswordReset"
+	log := s.log.With(slog.String("op", op))
+	user, err := s.repository.GetUserByEmail(ctx, email)
+	if errors.Is(err, utils.ErrorUserNotFound) {
+		log.Info("password reset requested for unknown email")
+		return nil
+	}
+	if err != nil {
+		log.Error("error getting user by email", slog.String("error", err.Error()))
+		return err
+	}
+	var token string
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if err = s.repository.InvalidateTokens(ctx, user.ID, TokenPurposePasswordReset); err != nil {
+			log.Error("error invalidating tokens", slog.String("error", err.Error()))
+			return err
+		}
+		token, err = s.issueToken(ctx, user.ID, TokenPurposePasswordReset, PasswordResetTTL)
+		if err != nil {
+			log.Error("error creating reset token", slog.String("error", err.Error()))
+			return err
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	locale := userLocale(ctx, user.Locale)
+	msg := mailer.Message{
+		To:      user.Email,
+		Subject: i18n.Message(locale, "email.password_reset.subject"),
+		Body: i18n.Message(locale, "email.password_reset.body",
+			PasswordResetTTL, fmt.Sprintf("%s/reset-password?token=%s", s.linkBaseURL, token)),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		log.Error("failed to send password reset email", slog.String("error", err.Error()))
+	}
+	return nil
+}
 
-	}()
+func (s *Service) ConfirmPasswordReset(ctx context.Context, token, password string) (err error) {
+	const op = "User.Service.ConfirmPasswordReset"
+	log := s.log.With(slog.String("op", op))
 	hashedPassword, err := GeneratePasswordHash(password)
 	if err != nil {
-		log.Error("error generating password hash", err.Error())
+		log.Error("error generating password hash", slog.String("error", err.Error()))
+		return err
+	}
+	var userID uuid.UUID
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		userID, err = s.repository.UseToken(ctx, TokenPurposePasswordReset, HashToken(token))
+		if err != nil {
+			log.Error("error using reset token", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+			log.Error("error updating password", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.InvalidateTokens(ctx, userID, TokenPurposePasswordReset); err != nil {
+			log.Error("error invalidating tokens", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.RevokeAllSessions(ctx, userID); err != nil {
+			log.Error("error revoking sessions", slog.String("error", err.Error()))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("password reset", slog.String("user_id", userID.String()))
+	return nil
+}
+
+func (s *Service) issueToken(ctx context.Context, userID uuid.UUID, purpose TokenPurpose, ttl time.Duration) (string, error) {
+	token, hash, err := GenerateToken()
+	if err != nil {
+		return "", err
+	}
+	if err := s.repository.CreateToken(ctx, userID, purpose, hash, time.Now().Add(ttl)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *Service) sendVerificationEmail(ctx context.Context, locale i18n.Locale, email, token string) {
+	msg := mailer.Message{
+		To:      email,
+		Subject: i18n.Message(locale, "email.verification.subject"),
+		Body: i18n.Message(locale, "email.verification.body",
+			EmailVerificationTTL, fmt.Sprintf("%s/verify-email?token=%s", s.linkBaseURL, token)),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		s.log.Error("failed to send verification email", slog.String("error", err.Error()))
+	}
+}
+
+func (s *Service) IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return s.repository.IsEmailVerified(ctx, userID)
+}
+
+func (s *Service) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	role, err := s.repository.GetRole(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return role == RoleAdmin, nil
+}
+
+// RecipientID ищет получателя перевода по email. Закрытые аккаунты не находятся.
+func (s *Service) RecipientID(ctx context.Context, email string) (uuid.UUID, error) {
+	user, err := s.repository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return user.ID, nil
+}
+
+func (s *Service) TwoFactorEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	secret, err := s.repository.GetTOTP(ctx, userID)
+	if errors.Is(err, utils.ErrorTwoFactorNotEnrolled) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return secret.EnabledAt != nil, nil
+}
+
+// EnrollTwoFactor генерирует новый TOTP секрет. 2FA включается только после подтверждения кодом в EnableTwoFactor.
+func (s *Service) EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (secret, uri string, err error) {
+	const op = "User.Service.EnrollTwoFactor"
+	log := s.log.With(slog.String("op", op))
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return "", "", err
+	}
+	secret, err = totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	encrypted, err := s.twoFactor.Box.Seal([]byte(secret))
+	if err != nil {
+		log.Error("error encrypting totp secret", slog.String("error", err.Error()))
+		return "", "", err
+	}
+	if err := s.repository.SaveTOTPSecret(ctx, userID, encrypted); err != nil {
+		log.Error("error saving totp secret", slog.String("error", err.Error()))
+		return "", "", err
+	}
+	return secret, totp.ProvisioningURI(s.twoFactor.Issuer, user.Email, secret), nil
+}
+
+// EnableTwoFactor подтверждает enrollment кодом из приложения и возвращает коды восстановления.
+func (s *Service) EnableTwoFactor(ctx context.Context, userID uuid.UUID, code string) (codes []string, err error) {
+	const op = "User.Service.EnableTwoFactor"
+	log := s.log.With(slog.String("op", op))
+	secret, err := s.repository.GetTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if secret.EnabledAt != nil {
+		return nil, utils.ErrorTwoFactorAlreadyEnabled
+	}
+	if err = s.verifyTOTP(ctx, secret, code); err != nil {
 		return nil, err
 	}
-	user, err := s.repository.CreateUser(ctx, email, username, hashedPassword, tx)
+	codes, hashes, err := GenerateRecoveryCodes(RecoveryCodesCount)
 	if err != nil {
-		log.Error("error creating user", err.Error())
 		return nil, err
 	}
-	if err := s.walletservice.CreateManyWallets(ctx, *user, tx); err != nil {
-		log.Error("error creating wallets", err.Error())
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if err = s.repository.EnableTOTP(ctx, userID); err != nil {
+			log.Error("error enabling totp", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+			log.Error("error saving recovery codes", slog.String("error", err.Error()))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	if err := tx.Commit(ctx); err != nil {
-		log.Error("tx commit error", err.Error())
+	log.Info("two-factor enabled", slog.String("user_id", userID.String()))
+	return codes, nil
+}
+
+func (s *Service) DisableTwoFactor(ctx context.Context, userID uuid.UUID, code string) (err error) {
+	const op = "User.Service.DisableTwoFactor"
+	log := s.log.With(slog.String("op", op))
+	if err = s.VerifyTwoFactor(ctx, userID, code); err != nil {
+		return err
 	}
-	log.Info("user created success", user.String())
-	return user, nil
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if err = s.repository.DeleteTOTP(ctx, userID); err != nil {
+			log.Error("error deleting totp", slog.String("error", err.Error()))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("two-factor disabled", slog.String("user_id", userID.String()))
+	return nil
 }
 
-func (s *Service) Login(ctx context.Context, email, password string) (*DatabaseUser, error) {
-	const op = "User.Service.Login"
+// VerifyTwoFactor принимает TOTP код или один из кодов восстановления.
+func (s *Service) VerifyTwoFactor(ctx context.Context, userID uuid.UUID, code string) error {
+	secret, err := s.repository.GetTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if secret.EnabledAt == nil {
+		return utils.ErrorTwoFactorNotEnrolled
+	}
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.verifyTOTP(ctx, secret, code)
+	}
+	return s.repository.UseRecoveryCode(ctx, userID, HashRecoveryCode(code))
+}
+
+// CompleteTwoFactorLogin завершает вход по challenge токену, выданному в Login. Неверный код считается
+// неудачной попыткой входа в аккаунт, как неверный пароль, а после ChallengeMaxFailures неверных кодов
+// challenge гасится: перебирать коды дальше можно только заново введя пароль.
+func (s *Service) CompleteTwoFactorLogin(ctx context.Context, challengeToken, code string) (*DatabaseUser, error) {
+	const op = "User.Service.CompleteTwoFactorLogin"
 	log := s.log.With(slog.String("op", op))
-	user, err := s.repository.GetUserByEmail(ctx, email)
+	claims, err := ParseChallengeToken(challengeToken)
+	if err != nil {
+		log.Error("invalid challenge token", slog.String("error", err.Error()))
+		return nil, utils.ErrorInvalidTwoFactorAuthFlow
+	}
+	challengeID := claims.RegisteredClaims.ID
+	failures, err := s.lockout.ChallengeFailures(ctx, challengeID)
 	if err != nil {
-		log.Error("error getting user by email", err.Error())
+		log.Error("error checking challenge failures", slog.String("error", err.Error()))
 		return nil, err
 	}
-	ok := VerifyPassword(user.Password, password)
+	if failures >= ChallengeMaxFailures {
+		return nil, utils.ErrorInvalidTwoFactorAuthFlow
+	}
+	user, err := s.repository.GetUserByID(ctx, claims.ID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return nil, err
+	}
+	lockedFor, err := s.lockout.LockedFor(ctx, user.Email)
+	if err != nil {
+		log.Error("error checking login lockout", slog.String("error", err.Error()))
+	}
+	if lockedFor > 0 {
+		return nil, &utils.RetryAfterError{Err: utils.ErrorAccountLocked, RetryAfter: lockedFor}
+	}
+	if err := s.VerifyTwoFactor(ctx, claims.ID, code); err != nil {
+		if !errors.Is(err, utils.ErrorInvalidTwoFactorCode) {
+			return nil, err
+		}
+		n, ferr := s.lockout.RegisterChallengeFailure(ctx, challengeID, time.Until(claims.ExpiresAt.Time))
+		if ferr != nil {
+			log.Error("error registering challenge failure", slog.String("error", ferr.Error()))
+			return nil, ferr
+		}
+		if lock := s.registerLoginFailure(ctx, user.Email); lock > 0 {
+			return nil, &utils.RetryAfterError{Err: utils.ErrorAccountLocked, RetryAfter: lock}
+		}
+		if n >= ChallengeMaxFailures {
+			log.Warn("two-factor challenge exhausted", slog.String("user_id", claims.ID.String()))
+			return nil, utils.ErrorInvalidTwoFactorAuthFlow
+		}
+		return nil, err
+	}
+	if err := s.lockout.Reset(ctx, user.Email); err != nil {
+		log.Error("error resetting login failures", slog.String("error", err.Error()))
+	}
+	user.TwoFactorEnabled = true
+	return user, nil
+}
+
+// RequireStepUp требует свежий код второго фактора для операций на сумму от порога валюты операции:
+// одна и та же сумма в RUB и в USD — разные деньги, поэтому порог задаётся для каждой валюты.
+// Пользователи без включённого 2FA проверку проходят.
+func (s *Service) RequireStepUp(ctx context.Context, userID uuid.UUID, amount float32, currency, code string) error {
+	if !s.twoFactor.stepUp(amount, currency) {
+		return nil
+	}
+	enabled, err := s.TwoFactorEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return utils.ErrorTwoFactorRequired
+	}
+	return s.VerifyTwoFactor(ctx, userID, code)
+}
+
+func (s *Service) verifyTOTP(ctx context.Context, secret *TOTPDB, code string) error {
+	plain, err := s.twoFactor.Box.Open(secret.SecretEncrypted)
+	if err != nil {
+		return fmt.Errorf("decrypt totp secret: %w", err)
+	}
+	step, ok := totp.Validate(string(plain), code, time.Now(), 1)
 	if !ok {
-		log.Error("invalid password")
-		return nil, utils.ErrorInvalidPassword
+		return utils.ErrorInvalidTwoFactorCode
 	}
-	log.Info("user service logged in user")
+	return s.repository.ConsumeTOTPStep(ctx, secret.UserID, step)
+}
+
+func (s *Service) registerLoginFailure(ctx context.Context, email string) time.Duration {
+	lock, err := s.lockout.RegisterFailure(ctx, email)
+	if err != nil {
+		s.log.Error("error registering login failure", slog.String("error", err.Error()))
+		return 0
+	}
+	if lock > 0 {
+		s.log.Warn("account locked after failed logins", slog.String("email", email), slog.Duration("lock", lock))
+	}
+	return lock
+}
+
+func (s *Service) GetProfile(ctx context.Context, userID uuid.UUID) (*DatabaseUser, error) {
+	const op = "User.Service.GetProfile"
+	log := s.log.With(slog.String("op", op))
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return user, nil
+}
+
+// UpdateProfile применяет изменения только к той версии профиля, которую видел клиент.
+// После смены email адрес нужно подтвердить заново.
+func (s *Service) UpdateProfile(ctx context.Context, userID uuid.UUID, version int64, username, email, locale *string) (user *DatabaseUser, err error) {
+	const op = "User.Service.UpdateProfile"
+	log := s.log.With(slog.String("op", op))
+	current, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if current.Version != version {
+		return nil, utils.ErrorVersionConflict
+	}
+	emailChanged := email != nil && !strings.EqualFold(*email, current.Email)
+	var token string
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		user, err = s.repository.UpdateProfile(ctx, userID, version, username, email, locale)
+		if err != nil {
+			log.Error("error updating profile", slog.String("error", err.Error()))
+			return err
+		}
+		if emailChanged {
+			if err = s.repository.InvalidateTokens(ctx, userID, TokenPurposeEmailVerification); err != nil {
+				log.Error("error invalidating tokens", slog.String("error", err.Error()))
+				return err
+			}
+			token, err = s.issueToken(ctx, userID, TokenPurposeEmailVerification, EmailVerificationTTL)
+			if err != nil {
+				log.Error("error creating verification token", slog.String("error", err.Error()))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if emailChanged {
+		s.sendVerificationEmail(ctx, userLocale(ctx, user.Locale), user.Email, token)
+	}
+	log.Info("profile updated", slog.String("user_id", userID.String()))
 	return user, nil
 }
+
+// ChangePassword меняет пароль и, как ConfirmPasswordReset, отзывает сессии: остаётся только
+// сессия sessionID, из которой пароль сменили, остальные устройства входят заново.
+func (s *Service) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, currentPassword, newPassword string) (err error) {
+	const op = "User.Service.ChangePassword"
+	log := s.log.With(slog.String("op", op))
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return err
+	}
+	if !VerifyPassword(user.Password, currentPassword) {
+		return utils.ErrorInvalidPassword
+	}
+	hashedPassword, err := GeneratePasswordHash(newPassword)
+	if err != nil {
+		log.Error("error generating password hash", slog.String("error", err.Error()))
+		return err
+	}
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if err = s.repository.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+			log.Error("error updating password", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.InvalidateTokens(ctx, userID, TokenPurposePasswordReset); err != nil {
+			log.Error("error invalidating tokens", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.RevokeOtherSessions(ctx, userID, sessionID); err != nil {
+			log.Error("error revoking sessions", slog.String("error", err.Error()))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("password changed", slog.String("user_id", userID.String()))
+	return nil
+}
+
+// CloseAccount закрывает аккаунт. Закрыть можно только аккаунт с нулевыми балансами, без открытых
+// лимитных ордеров и незавершённых платежей: провайдер может вернуть отклонённую выплату уже на закрытый кошелёк.
+// Активные и приостановленные расписания отменяются вместе с ним.
+// После закрытия email и username снова доступны для регистрации.
+func (s *Service) CloseAccount(ctx context.Context, userID uuid.UUID, password string) (err error) {
+	const op = "User.Service.CloseAccount"
+	log := s.log.With(slog.String("op", op))
+	// нулевой баланс проверяется по основной базе: на отстающей реплике могут не быть последние зачисления
+	ctx = db.WithPrimary(ctx)
+	user, err := s.repository.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("error getting user by id", slog.String("error", err.Error()))
+		return err
+	}
+	if !VerifyPassword(user.Password, password) {
+		return utils.ErrorInvalidPassword
+	}
+	balance, err := s.walletservice.Balance(ctx, userID)
+	if err != nil {
+		log.Error("error getting balance", slog.String("error", err.Error()))
+		return err
+	}
+	for _, amount := range balance.Balances {
+		if amount != 0 {
+			return utils.ErrorAccountHasBalance
+		}
+	}
+	hasOrders, err := s.repository.HasOpenOrders(ctx, userID)
+	if err != nil {
+		log.Error("error checking open orders", slog.String("error", err.Error()))
+		return err
+	}
+	if hasOrders {
+		return utils.ErrorAccountHasOpenOrders
+	}
+	hasPayments, err := s.repository.HasPendingPayments(ctx, userID)
+	if err != nil {
+		log.Error("error checking pending payments", slog.String("error", err.Error()))
+		return err
+	}
+	if hasPayments {
+		return utils.ErrorAccountHasPayments
+	}
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		if err = s.repository.CloseAccount(ctx, userID); err != nil {
+			log.Error("error closing account", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.RevokeAllSessions(ctx, userID); err != nil {
+			log.Error("error revoking sessions", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.CancelSchedules(ctx, userID); err != nil {
+			log.Error("error cancelling schedules", slog.String("error", err.Error()))
+			return err
+		}
+		for _, purpose := range []TokenPurpose{TokenPurposeEmailVerification, TokenPurposePasswordReset} {
+			if err = s.repository.InvalidateTokens(ctx, userID, purpose); err != nil {
+				log.Error("error invalidating tokens", slog.String("error", err.Error()))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("account closed", slog.String("user_id", userID.String()))
+	return nil
+}
+
+// StartSession регистрирует вход с устройства. Если notifyNewDevice и с этого устройства
+// пользователь раньше не входил, в outbox пишется событие о входе с нового устройства.
+func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, meta SessionMeta, notifyNewDevice bool) (sessionID uuid.UUID, err error) {
+	const op = "User.Service.StartSession"
+	log := s.log.With(slog.String("op", op))
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		known, err := s.repository.KnownDevice(ctx, userID, meta.UserAgent)
+		if err != nil {
+			log.Error("error checking known device", slog.String("error", err.Error()))
+			return err
+		}
+		sessionID, err = s.repository.CreateSession(ctx, userID, meta, time.Now().Add(RefreshTokenTTL))
+		if err != nil {
+			log.Error("error creating session", slog.String("error", err.Error()))
+			return err
+		}
+		if notifyNewDevice && !known {
+			locale, err := s.NotificationLocale(ctx, userID)
+			if err != nil {
+				log.Error("error getting notification locale", slog.String("error", err.Error()))
+				return err
+			}
+			payload, err := json.Marshal(NewDeviceLoginPayload{
+				UserID:    userID,
+				SessionID: sessionID,
+				Device:    meta.Device,
+				IP:        meta.IP,
+				UserAgent: meta.UserAgent,
+				LoginAt:   time.Now().UTC(),
+				Code:      NotificationNewDeviceLogin,
+				Locale:    string(locale),
+				Message:   i18n.Message(locale, NotificationNewDeviceLogin, meta.Device, meta.IP),
+			})
+			if err != nil {
+				return err
+			}
+			if _, err = s.events.CreateEvent(ctx, EventTypeNewDeviceLogin, string(payload)); err != nil {
+				log.Error("error creating new device event", slog.String("error", err.Error()))
+				return err
+			}
+		}
+		if err = s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionLogin,
+			ActorID:  &userID,
+			TargetID: &userID,
+			After:    map[string]string{"session_id": sessionID.String(), "device": meta.Device, "user_agent": meta.UserAgent},
+		}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return sessionID, nil
+}
+
+// NotificationLocale возвращает язык уведомлений пользователя: выбранный в профиле,
+// а если он не задан — язык текущего запроса. Закрытый аккаунт тоже получает уведомления:
+// воркеры и вебхуки могут завершать его операции уже после закрытия.
+func (s *Service) NotificationLocale(ctx context.Context, userID uuid.UUID) (i18n.Locale, error) {
+	locale, err := s.repository.GetLocale(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return userLocale(ctx, locale), nil
+}
+
+func userLocale(ctx context.Context, locale *string) i18n.Locale {
+	if locale != nil {
+		if l, ok := i18n.Parse(*locale); ok {
+			return l
+		}
+	}
+	return i18n.FromContext(ctx)
+}
+
+func (s *Service) ListSessions(ctx context.Context, userID uuid.UUID) ([]*SessionDB, error) {
+	const op = "User.Service.ListSessions"
+	log := s.log.With(slog.String("op", op))
+	sessions, err := s.repository.ListSessions(ctx, userID)
+	if err != nil {
+		log.Error("error listing sessions", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (s *Service) TouchSession(ctx context.Context, sessionID, userID uuid.UUID, ip string) (bool, error) {
+	return s.repository.TouchSession(ctx, sessionID, userID, ip)
+}
+
+func (s *Service) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	const op = "User.Service.RevokeSession"
+	log := s.log.With(slog.String("op", op))
+	if err := s.repository.RevokeSession(ctx, sessionID, userID); err != nil {
+		log.Error("error revoking session", slog.String("error", err.Error()))
+		return err
+	}
+	log.Info("session revoked", slog.String("session_id", sessionID.String()))
+	return nil
+}
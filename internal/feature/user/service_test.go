@@ -0,0 +1,344 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+	"github.com/Sanchir01/currency-wallet/pkg/totp"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// fakeTOTPRepository хранит TOTP-секрет в памяти и, как UPDATE в ConsumeTOTPStep,
+// принимает только интервал новее последнего использованного. Остальные методы тестам не нужны.
+type fakeTOTPRepository struct {
+	ServiceUser
+	secret *TOTPDB
+}
+
+func (r *fakeTOTPRepository) GetTOTP(_ context.Context, _ uuid.UUID) (*TOTPDB, error) {
+	if r.secret == nil {
+		return nil, utils.ErrorTwoFactorNotEnrolled
+	}
+	return r.secret, nil
+}
+
+func (r *fakeTOTPRepository) ConsumeTOTPStep(_ context.Context, _ uuid.UUID, step int64) error {
+	if step <= r.secret.LastUsedStep {
+		return utils.ErrorInvalidTwoFactorCode
+	}
+	r.secret.LastUsedStep = step
+	return nil
+}
+
+func (r *fakeTOTPRepository) UseRecoveryCode(_ context.Context, _ uuid.UUID, _ []byte) error {
+	return utils.ErrorInvalidTwoFactorCode
+}
+
+func (r *fakeTOTPRepository) GetUserByID(_ context.Context, id uuid.UUID) (*DatabaseUser, error) {
+	return &DatabaseUser{ID: id, Email: "alice@example.com"}, nil
+}
+
+// fakeLockout считает неудачные входы по аккаунту и неверные коды по challenge в памяти:
+// аккаунт блокируется на минуту с lockAt-й неудачи, lockAt = 0 блокировку выключает.
+type fakeLockout struct {
+	lockAt     int
+	failures   map[string]int
+	challenges map[string]int64
+}
+
+func newFakeLockout(lockAt int) *fakeLockout {
+	return &fakeLockout{lockAt: lockAt, failures: map[string]int{}, challenges: map[string]int64{}}
+}
+
+func (l *fakeLockout) LockedFor(_ context.Context, account string) (time.Duration, error) {
+	if l.lockAt > 0 && l.failures[account] >= l.lockAt {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (l *fakeLockout) RegisterFailure(ctx context.Context, account string) (time.Duration, error) {
+	l.failures[account]++
+	return l.LockedFor(ctx, account)
+}
+
+func (l *fakeLockout) Reset(_ context.Context, account string) error {
+	delete(l.failures, account)
+	return nil
+}
+
+func (l *fakeLockout) RegisterChallengeFailure(_ context.Context, challengeID string, _ time.Duration) (int64, error) {
+	l.challenges[challengeID]++
+	return l.challenges[challengeID], nil
+}
+
+func (l *fakeLockout) ChallengeFailures(_ context.Context, challengeID string) (int64, error) {
+	return l.challenges[challengeID], nil
+}
+
+// newTOTPService возвращает сервис с включённым 2FA у пользователя и открытый секрет.
+func newTOTPService(t *testing.T, thresholds map[string]float32) (*Service, string) {
+	t.Helper()
+	box, err := secretbox.New(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("secretbox: %v", err)
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatalf("generate secret: %v", err)
+	}
+	encrypted, err := box.Seal([]byte(secret))
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	enabledAt := time.Now()
+	repo := &fakeTOTPRepository{secret: &TOTPDB{SecretEncrypted: encrypted, EnabledAt: &enabledAt}}
+	s := NewService(repo, nil, nil, nil, "", TwoFactorOptions{Box: box, StepUpThresholds: thresholds},
+		nil, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return s, secret
+}
+
+// currentStep возвращает текущий интервал TOTP так, чтобы до его конца оставалось не меньше секунды.
+func currentStep(t *testing.T) int64 {
+	t.Helper()
+	next := time.Unix((totp.Step(time.Now())+1)*int64(totp.Period/time.Second), 0)
+	if left := time.Until(next); left < time.Second {
+		time.Sleep(left + 50*time.Millisecond)
+	}
+	return totp.Step(time.Now())
+}
+
+func code(t *testing.T, secret string, step int64) string {
+	t.Helper()
+	c, err := totp.Code(secret, step)
+	if err != nil {
+		t.Fatalf("code: %v", err)
+	}
+	return c
+}
+
+// TestRequireStepUp — порог сравнивается с суммой в валюте операции, валюта без порога требует код всегда.
+func TestRequireStepUp(t *testing.T) {
+	thresholds := map[string]float32{"USD": 1000, "RUB": 100000}
+	ctx := context.Background()
+	userID := uuid.New()
+	cases := []struct {
+		name       string
+		thresholds map[string]float32
+		amount     float32
+		currency   string
+		want       error
+	}{
+		{"below threshold", thresholds, 999, "USD", nil},
+		{"at threshold", thresholds, 1000, "USD", utils.ErrorTwoFactorRequired},
+		{"same number in cheaper currency", thresholds, 5000, "RUB", nil},
+		{"threshold of cheaper currency", thresholds, 100000, "RUB", utils.ErrorTwoFactorRequired},
+		{"currency without threshold", thresholds, 1, "EUR", utils.ErrorTwoFactorRequired},
+		{"step-up disabled", nil, 1_000_000, "USD", nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s, _ := newTOTPService(t, c.thresholds)
+			if err := s.RequireStepUp(ctx, userID, c.amount, c.currency, ""); !errors.Is(err, c.want) {
+				t.Errorf("RequireStepUp(%v %s) = %v, want %v", c.amount, c.currency, err, c.want)
+			}
+		})
+	}
+
+	s, secret := newTOTPService(t, thresholds)
+	if err := s.RequireStepUp(ctx, userID, 5000, "USD", code(t, secret, currentStep(t))); err != nil {
+		t.Errorf("step-up with valid code: %v", err)
+	}
+	s.repository.(*fakeTOTPRepository).secret = nil
+	if err := s.RequireStepUp(ctx, userID, 5000, "USD", ""); err != nil {
+		t.Errorf("step-up without 2FA enrolled: %v", err)
+	}
+}
+
+// TestVerifyTOTP — код принимается с допуском в один интервал, но каждый интервал только один раз:
+// повтор кода и код старше последнего использованного отклоняются.
+func TestVerifyTOTP(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("replay", func(t *testing.T) {
+		s, secret := newTOTPService(t, nil)
+		c := code(t, secret, currentStep(t))
+		if err := s.VerifyTwoFactor(ctx, userID, c); err != nil {
+			t.Fatalf("first use: %v", err)
+		}
+		if err := s.VerifyTwoFactor(ctx, userID, c); !errors.Is(err, utils.ErrorInvalidTwoFactorCode) {
+			t.Errorf("replay: %v, want invalid code", err)
+		}
+	})
+
+	t.Run("window", func(t *testing.T) {
+		s, secret := newTOTPService(t, nil)
+		step := currentStep(t)
+		if err := s.VerifyTwoFactor(ctx, userID, code(t, secret, step-2)); !errors.Is(err, utils.ErrorInvalidTwoFactorCode) {
+			t.Errorf("code two steps old: %v, want invalid code", err)
+		}
+		if err := s.VerifyTwoFactor(ctx, userID, code(t, secret, step-1)); err != nil {
+			t.Errorf("previous step: %v", err)
+		}
+		if err := s.VerifyTwoFactor(ctx, userID, code(t, secret, step+1)); err != nil {
+			t.Errorf("next step: %v", err)
+		}
+		// интервал step младше уже использованного step+1
+		if err := s.VerifyTwoFactor(ctx, userID, code(t, secret, step)); !errors.Is(err, utils.ErrorInvalidTwoFactorCode) {
+			t.Errorf("older step after newer one: %v, want invalid code", err)
+		}
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		s, secret := newTOTPService(t, nil)
+		c := []byte(code(t, secret, currentStep(t)))
+		c[0] = '0' + (c[0]-'0'+1)%10
+		if err := s.VerifyTwoFactor(ctx, userID, string(c)); !errors.Is(err, utils.ErrorInvalidTwoFactorCode) {
+			t.Errorf("wrong code: %v, want invalid code", err)
+		}
+	})
+}
+
+// TestCompleteTwoFactorLogin — неверные коды считаются неудачными входами в аккаунт,
+// а challenge после ChallengeMaxFailures неверных кодов не принимает и верный код.
+func TestCompleteTwoFactorLogin(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	wrong := func(c string) string {
+		b := []byte(c)
+		b[0] = '0' + (b[0]-'0'+1)%10
+		return string(b)
+	}
+
+	t.Run("challenge exhausted", func(t *testing.T) {
+		s, secret := newTOTPService(t, nil)
+		lockout := newFakeLockout(0)
+		s.lockout = lockout
+		challenge, err := GenerateChallengeToken(userID)
+		if err != nil {
+			t.Fatalf("challenge: %v", err)
+		}
+		c := code(t, secret, currentStep(t))
+		for i := 1; i <= ChallengeMaxFailures; i++ {
+			want := utils.ErrorInvalidTwoFactorCode
+			if i == ChallengeMaxFailures {
+				want = utils.ErrorInvalidTwoFactorAuthFlow
+			}
+			if _, err := s.CompleteTwoFactorLogin(ctx, challenge, wrong(c)); !errors.Is(err, want) {
+				t.Fatalf("wrong code %d: %v, want %v", i, err, want)
+			}
+		}
+		if _, err := s.CompleteTwoFactorLogin(ctx, challenge, c); !errors.Is(err, utils.ErrorInvalidTwoFactorAuthFlow) {
+			t.Errorf("valid code on exhausted challenge: %v, want invalid challenge", err)
+		}
+		if got := lockout.failures["alice@example.com"]; got != ChallengeMaxFailures {
+			t.Errorf("login failures = %d, want %d", got, ChallengeMaxFailures)
+		}
+
+		fresh, err := GenerateChallengeToken(userID)
+		if err != nil {
+			t.Fatalf("challenge: %v", err)
+		}
+		if _, err := s.CompleteTwoFactorLogin(ctx, fresh, c); err != nil {
+			t.Fatalf("valid code on new challenge: %v", err)
+		}
+		if got := lockout.failures["alice@example.com"]; got != 0 {
+			t.Errorf("login failures after success = %d, want 0", got)
+		}
+	})
+
+	t.Run("account locked", func(t *testing.T) {
+		s, secret := newTOTPService(t, nil)
+		s.lockout = newFakeLockout(2)
+		c := code(t, secret, currentStep(t))
+		for i, want := range []error{utils.ErrorInvalidTwoFactorCode, utils.ErrorAccountLocked} {
+			// каждый раз новый challenge: блокировка аккаунта не зависит от challenge
+			challenge, err := GenerateChallengeToken(userID)
+			if err != nil {
+				t.Fatalf("challenge: %v", err)
+			}
+			if _, err := s.CompleteTwoFactorLogin(ctx, challenge, wrong(c)); !errors.Is(err, want) {
+				t.Fatalf("wrong code %d: %v, want %v", i+1, err, want)
+			}
+		}
+		challenge, err := GenerateChallengeToken(userID)
+		if err != nil {
+			t.Fatalf("challenge: %v", err)
+		}
+		if _, err := s.CompleteTwoFactorLogin(ctx, challenge, c); !errors.Is(err, utils.ErrorAccountLocked) {
+			t.Errorf("valid code on locked account: %v, want account locked", err)
+		}
+	})
+}
+
+// fakeTx — транзакция в контексте для сервиса с поддельным репозиторием, её методы не вызываются.
+type fakeTx struct{ pgx.Tx }
+
+// fakeSessionsRepository хранит пароль и активные сессии одного пользователя в памяти.
+type fakeSessionsRepository struct {
+	ServiceUser
+	password []byte
+	sessions map[uuid.UUID]bool
+}
+
+func (r *fakeSessionsRepository) GetUserByID(_ context.Context, id uuid.UUID) (*DatabaseUser, error) {
+	return &DatabaseUser{ID: id, Email: "alice@example.com", Password: r.password}, nil
+}
+
+func (r *fakeSessionsRepository) UpdatePassword(_ context.Context, _ uuid.UUID, password []byte) error {
+	r.password = password
+	return nil
+}
+
+func (r *fakeSessionsRepository) InvalidateTokens(_ context.Context, _ uuid.UUID, _ TokenPurpose) error {
+	return nil
+}
+
+func (r *fakeSessionsRepository) RevokeOtherSessions(_ context.Context, _, keepID uuid.UUID) error {
+	for id := range r.sessions {
+		if id != keepID {
+			delete(r.sessions, id)
+		}
+	}
+	return nil
+}
+
+// TestChangePassword — после смены пароля остаётся только сессия, из которой его сменили,
+// а с неверным текущим паролем сессии не трогаются.
+func TestChangePassword(t *testing.T) {
+	ctx := db.WithTx(context.Background(), fakeTx{})
+	hash, err := GeneratePasswordHash("old-password")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	current, other := uuid.New(), uuid.New()
+	repo := &fakeSessionsRepository{password: hash, sessions: map[uuid.UUID]bool{current: true, other: true}}
+	s := NewService(repo, nil, nil, nil, "", TwoFactorOptions{}, nil, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	if err := s.ChangePassword(ctx, uuid.New(), current, "wrong-password", "new-password"); !errors.Is(err, utils.ErrorInvalidPassword) {
+		t.Fatalf("wrong current password: %v, want invalid password", err)
+	}
+	if len(repo.sessions) != 2 {
+		t.Errorf("sessions after failed change = %d, want 2", len(repo.sessions))
+	}
+
+	if err := s.ChangePassword(ctx, uuid.New(), current, "old-password", "new-password"); err != nil {
+		t.Fatalf("change password: %v", err)
+	}
+	if !repo.sessions[current] || len(repo.sessions) != 1 {
+		t.Errorf("sessions after change = %v, want only the current one", repo.sessions)
+	}
+	if !VerifyPassword(repo.password, "new-password") {
+		t.Error("password was not updated")
+	}
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+const EventTypeNewDeviceLogin = "SECURITY_NEW_DEVICE_LOGIN"
+
+// NotificationNewDeviceLogin — код сообщения о входе с нового устройства в каталоге i18n.
+const NotificationNewDeviceLogin = "notification.new_device_login"
+
+// SessionMeta описывает клиента, с которого выполнен вход.
+type SessionMeta struct {
+	Device    string
+	IP        string
+	UserAgent string
+}
+
+// SessionMetaFromRequest собирает данные клиента. IP уже подменён middleware.RealIP,
+// устройство можно явно передать заголовком X-Device-Name, иначе оно угадывается по User-Agent.
+func SessionMetaFromRequest(r *http.Request) SessionMeta {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	device := strings.TrimSpace(r.Header.Get("X-Device-Name"))
+	if device == "" {
+		device = DeviceFromUserAgent(r.UserAgent())
+	}
+	return SessionMeta{
+		Device:    device,
+		IP:        ip,
+		UserAgent: r.UserAgent(),
+	}
+}
+
+func DeviceFromUserAgent(ua string) string {
+	lower := strings.ToLower(ua)
+	browser := "Unknown client"
+	switch {
+	case strings.Contains(lower, "edg/"):
+		browser = "Edge"
+	case strings.Contains(lower, "opr/"), strings.Contains(lower, "opera"):
+		browser = "Opera"
+	case strings.Contains(lower, "yabrowser"):
+		browser = "Yandex Browser"
+	case strings.Contains(lower, "chrome/"):
+		browser = "Chrome"
+	case strings.Contains(lower, "firefox/"):
+		browser = "Firefox"
+	case strings.Contains(lower, "safari/"):
+		browser = "Safari"
+	case strings.Contains(lower, "curl/"):
+		browser = "curl"
+	case strings.Contains(lower, "okhttp"), strings.Contains(lower, "dalvik"):
+		browser = "Android app"
+	case strings.Contains(lower, "cfnetwork"):
+		browser = "iOS app"
+	}
+	platform := ""
+	switch {
+	case strings.Contains(lower, "android"):
+		platform = "Android"
+	case strings.Contains(lower, "iphone"), strings.Contains(lower, "ipad"):
+		platform = "iOS"
+	case strings.Contains(lower, "windows"):
+		platform = "Windows"
+	case strings.Contains(lower, "mac os"), strings.Contains(lower, "macintosh"):
+		platform = "macOS"
+	case strings.Contains(lower, "linux"):
+		platform = "Linux"
+	}
+	if platform == "" {
+		return browser
+	}
+	return browser + " on " + platform
+}
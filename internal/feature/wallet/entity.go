@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"time"
+
 	"github.com/Sanchir01/currency-wallet/internal/domain/models"
 	"github.com/Sanchir01/currency-wallet/pkg/api"
 	"github.com/google/uuid"
@@ -13,8 +15,9 @@ type CurrencyWalletResponse struct {
 	Rates map[string]float32
 }
 type DepositOrWithdrawRequest struct {
-	Amount   float32 `json:"amount" validate:"required"`
-	Currency string  `json:"currency" validate:"required"`
+	Amount   float32 `json:"amount" validate:"required,money"`
+	Currency string  `json:"currency" validate:"required,currency"`
+	TOTPCode string  `json:"totp_code,omitempty"`
 }
 
 type DepositOrWithdrawResponse struct {
@@ -23,9 +26,9 @@ type DepositOrWithdrawResponse struct {
 }
 
 type ExchangeRequest struct {
-	FromCurrency string  `json:"from_currency" validate:"required"`
-	ToCurrency   string  `json:"to_currency" validate:"required"`
-	Amount       float32 `json:"amount" validate:"required"`
+	FromCurrency string  `json:"from_currency" validate:"required,currency"`
+	ToCurrency   string  `json:"to_currency" validate:"required,currency,nefield=FromCurrency"`
+	Amount       float32 `json:"amount" validate:"required,money"`
 }
 type ExchangeResponse struct {
 	api.Response
@@ -38,12 +41,186 @@ type KafkaPayloadNotification struct {
 	Amount       float32            `json:"amount"`
 	UserId       uuid.UUID          `json:"user_id"`
 	BalanceAfter map[string]float32 `json:"balance_after"`
+	Currency     string             `json:"currency,omitempty"`
+	Code         string             `json:"code"`
+	Locale       string             `json:"locale"`
+	Message      string             `json:"message"`
 }
+
+// Коды сообщений уведомлений в каталоге i18n.
+const (
+	NotificationDeposit  = "notification.deposit"
+	NotificationWithdraw = "notification.withdraw"
+	NotificationExchange = "notification.exchange"
+	NotificationTransfer = "notification.transfer_received"
+)
+
+// ExchangeRateToCurrency — курс пары и снимок в истории курсов, на который ссылаются операции обмена по нему.
 type ExchangeRateToCurrency struct {
-	FromCurrency string  `json:"from_currency"`
-	ToCurrency   string  `json:"to_currency"`
-	Rate         float32 `json:"rate"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         float32   `json:"rate"`
+	SnapshotID   uuid.UUID `json:"snapshot_id"`
+	CapturedAt   time.Time `json:"captured_at"`
 }
+
+// DefaultRedis — курсы всех валют в кеше вместе с моментом получения от обменника.
 type DefaultRedis struct {
-	Rates map[string]float64 `json:"rates"`
+	Rates     map[string]float64 `json:"rates"`
+	FetchedAt time.Time          `json:"fetched_at"`
+}
+
+// Balance — состояние кошелька: Held зарезервировано холдами, Available можно потратить.
+type Balance struct {
+	Total     float32 `json:"total"`
+	Held      float32 `json:"held"`
+	Available float32 `json:"available"`
+}
+
+type BalanceResponse struct {
+	api.Response
+	// Rates — общий баланс по валютам, оставлен для старых клиентов
+	Rates    map[string]float32 `json:"Rates"`
+	Balances map[string]Balance `json:"balances"`
+	// Valuation заполняется, только если передан параметр valuation
+	Valuation *Valuation `json:"valuation,omitempty"`
+}
+
+// BalanceRequest — параметры /balance: valuation — валюта отчёта для оценки всех кошельков.
+type BalanceRequest struct {
+	Valuation string `json:"valuation" validate:"omitempty,currency"`
+}
+
+// WalletValuation — кошелёк в валюте отчёта: Rate — сколько единиц валюты отчёта стоит единица валюты кошелька.
+type WalletValuation struct {
+	Amount float64 `json:"amount"`
+	Rate   float64 `json:"rate"`
+	Value  float64 `json:"value"`
+}
+
+// Valuation — стоимость всех кошельков в валюте отчёта по курсам на момент RatesAt.
+type Valuation struct {
+	Currency string                     `json:"currency"`
+	Total    float64                    `json:"total"`
+	RatesAt  time.Time                  `json:"rates_at"`
+	Wallets  map[string]WalletValuation `json:"wallets"`
+}
+
+// ValuationPoint — стоимость кошельков на конец дня по снимкам балансов и курсов.
+type ValuationPoint struct {
+	Date  string  `json:"date"`
+	Total float64 `json:"total"`
+}
+
+type ValuationHistoryRequest struct {
+	Valuation string `json:"valuation" validate:"required,currency"`
+	From      string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To        string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type ValuationHistoryResponse struct {
+	api.Response
+	Currency string           `json:"currency"`
+	Points   []ValuationPoint `json:"points"`
+}
+
+type HoldStatus string
+
+const (
+	HoldAuthorized HoldStatus = "AUTHORIZED"
+	HoldCaptured   HoldStatus = "CAPTURED"
+	HoldVoided     HoldStatus = "VOIDED"
+	HoldExpired    HoldStatus = "EXPIRED"
+)
+
+// Типы событий outbox и коды уведомлений для холдов.
+const (
+	EventTypeHoldAuthorized = "HOLD_AUTHORIZED"
+	EventTypeHoldCaptured   = "HOLD_CAPTURED"
+	EventTypeHoldVoided     = "HOLD_VOIDED"
+	EventTypeHoldExpired    = "HOLD_EXPIRED"
+
+	NotificationHoldAuthorized = "notification.hold_authorized"
+	NotificationHoldCaptured   = "notification.hold_captured"
+	NotificationHoldVoided     = "notification.hold_voided"
+	NotificationHoldExpired    = "notification.hold_expired"
+)
+
+type HoldDB struct {
+	ID             uuid.UUID  `db:"id"`
+	UserID         uuid.UUID  `db:"user_id"`
+	WalletID       uuid.UUID  `db:"wallet_id"`
+	Currency       string     `db:"currency"`
+	Amount         float32    `db:"amount"`
+	CapturedAmount *float32   `db:"captured_amount"`
+	Status         HoldStatus `db:"status"`
+	Reference      *string    `db:"reference"`
+	ExpiresAt      time.Time  `db:"expires_at"`
+	ClosedAt       *time.Time `db:"closed_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// AuthorizeHoldRequest резервирует сумму. reference — идентификатор операции на стороне интеграции,
+// без expires_at холд живёт holds.default_ttl.
+type AuthorizeHoldRequest struct {
+	Currency  string     `json:"currency" validate:"required,currency"`
+	Amount    float32    `json:"amount" validate:"required,money"`
+	Reference *string    `json:"reference,omitempty" validate:"omitempty,min=1,max=128"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	TOTPCode  string     `json:"totp_code,omitempty"`
+}
+
+// CaptureHoldRequest списывает захолдированную сумму, без amount — целиком.
+// Остаток частичного списания освобождается.
+type CaptureHoldRequest struct {
+	Amount *float32 `json:"amount,omitempty" validate:"omitempty,money"`
+}
+
+type Hold struct {
+	ID             uuid.UUID  `json:"id"`
+	Currency       string     `json:"currency"`
+	Amount         float32    `json:"amount"`
+	CapturedAmount *float32   `json:"captured_amount,omitempty"`
+	Status         HoldStatus `json:"status"`
+	Reference      *string    `json:"reference,omitempty"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ClosedAt       *time.Time `json:"closed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func NewHold(h *HoldDB) Hold {
+	return Hold{
+		ID:             h.ID,
+		Currency:       h.Currency,
+		Amount:         h.Amount,
+		CapturedAmount: h.CapturedAmount,
+		Status:         h.Status,
+		Reference:      h.Reference,
+		ExpiresAt:      h.ExpiresAt,
+		ClosedAt:       h.ClosedAt,
+		CreatedAt:      h.CreatedAt,
+	}
+}
+
+type HoldResponse struct {
+	api.Response
+	Hold Hold `json:"hold"`
+}
+
+type HoldsResponse struct {
+	api.Response
+	Holds []Hold `json:"holds"`
+}
+
+type KafkaPayloadHold struct {
+	HoldID         uuid.UUID  `json:"hold_id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	Status         HoldStatus `json:"status"`
+	Currency       string     `json:"currency"`
+	Amount         float32    `json:"amount"`
+	CapturedAmount *float32   `json:"captured_amount,omitempty"`
+	Reference      *string    `json:"reference,omitempty"`
+	Code           string     `json:"code"`
+	Locale         string     `json:"locale"`
+	Message        string     `json:"message"`
 }
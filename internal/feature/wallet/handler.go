@@ -5,11 +5,14 @@ import (
 	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
 	"github.com/Sanchir01/currency-wallet/internal/domain/models"
 	httphandlers "github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/Sanchir01/currency-wallet/internal/http/request"
 	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
 	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
@@ -17,10 +20,18 @@ import (
 
 type HandlerWallets interface {
 	GetCurrencyWallets(ctx context.Context) (*models.CurrencyWallet, error)
-	GetBalance(ctx context.Context, id uuid.UUID) (*models.CurrencyWallet, error)
+	GetBalance(ctx context.Context, id uuid.UUID) (map[string]Balance, error)
+	AuthorizeWithdraw(ctx context.Context, id uuid.UUID, amount float32, currency, totpCode string) error
 	WalletDepositOrWithDraw(ctx context.Context, id uuid.UUID, currency string, amount float32, typedepo contextkey.OperationType) (*models.CurrencyWallet, error)
 	GetExchangeRateForCurrency(ctx context.Context, to_currency, from_currency string) (*ExchangeRateToCurrency, error)
-	CurrencyExchangeWallet(ctx context.Context, userid uuid.UUID, to_currency, from_currency string, to_currency_amount, from_currency_amount float32) (*models.CurrencyWallet, error)
+	CurrencyExchangeWallet(ctx context.Context, userid uuid.UUID, rate *ExchangeRateToCurrency, to_currency_amount, from_currency_amount float32) (*models.CurrencyWallet, error)
+	AuthorizeHold(ctx context.Context, userID uuid.UUID, req AuthorizeHoldRequest) (*HoldDB, error)
+	CaptureHold(ctx context.Context, userID, id uuid.UUID, amount *float32) (*HoldDB, error)
+	VoidHold(ctx context.Context, userID, id uuid.UUID) (*HoldDB, error)
+	GetHold(ctx context.Context, userID, id uuid.UUID) (*HoldDB, error)
+	ListHolds(ctx context.Context, userID uuid.UUID, status *HoldStatus) ([]*HoldDB, error)
+	ValueBalances(ctx context.Context, balances map[string]Balance, currency string) (*Valuation, error)
+	ValuationHistory(ctx context.Context, userID uuid.UUID, req ValuationHistoryRequest) ([]ValuationPoint, error)
 }
 
 type Handler struct {
@@ -35,24 +46,23 @@ func NewHandler(s HandlerWallets, log *slog.Logger) *Handler {
 	}
 }
 
-// @Summary GetAllCurrencyHandler
+// @Summary ExchangeRates
+// @ID exchangeRates
 // @Tags wallet
-// @Description all currency wallet
-// @Accept json
+// @Description current exchange rates of all supported currencies
 // @Produce json
 // @Success 200 {object}  CurrencyWalletResponse
-// @Failure 400,404 {object}  api.Response
-// @Failure 500 {object}  api.Response
-// @Security refreshToken
-// @Router /exchanger/rates [get]
+// @Failure 401 {object}  api.Response
+// @Failure 500,503 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /exchange/rates [get]
 func (h *Handler) GetAllCurrencyHandler(w http.ResponseWriter, r *http.Request) {
 	const op = "Wallet.Handler.GetAllCurrency"
 	log := h.log.With(slog.String("op", op))
 	data, err := h.s.GetCurrencyWallets(r.Context())
 	if err != nil {
-		log.Error("failed get ", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("failed get currency wallet"))
+		log.Error("failed get ", slog.String("error", err.Error()))
+		api.WriteError(w, r, err)
 		return
 	}
 	log.Info("Successfully fetched currency data")
@@ -61,48 +71,121 @@ func (h *Handler) GetAllCurrencyHandler(w http.ResponseWriter, r *http.Request)
 	})
 }
 
-// @Summary GetBalanceHandler
+// @Summary Balance
+// @ID getBalance
 // @Tags wallet
-// @Description balance user
-// @Accept json
+// @Description balances of all wallets of the current user: total, held by open holds and available to spend.
+// @Description Rates keeps the total per currency for older clients. With valuation every wallet is also
+// @Description converted to that currency at the current cached rates, together with the total net worth
 // @Produce json
-// @Success 200 {object}  CurrencyWalletResponse
-// @Failure 400,404 {object}  api.Response
-// @Failure 500 {object}  api.Response
-// @Security refreshToken
+// @Param valuation query string false "reporting currency" Enums(USD, EUR, RUB)
+// @Success 200 {object}  BalanceResponse
+// @Failure 400,401 {object}  api.Response
+// @Failure 500,503 {object}  api.Response
+// @Security AccessTokenCookie
 // @Router /balance [get]
 func (h *Handler) GetBalanceHandler(w http.ResponseWriter, r *http.Request) {
 	const op = "Wallet.Handler.GetAllCurrency"
 	log := h.log.With(slog.String("op", op))
 	userid, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
 	if err != nil {
-		log.Error("failed get user id from jwt", err.Error())
-		render.Status(r, http.StatusUnauthorized)
-		render.JSON(w, r, api.Error("Unauthorized"))
+		log.Error("failed get user id from jwt", slog.String("error", err.Error()))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	req := BalanceRequest{Valuation: r.URL.Query().Get("valuation")}
+	if err := request.Validate(&req); err != nil {
+		api.WriteError(w, r, err)
 		return
 	}
 	data, err := h.s.GetBalance(r.Context(), userid.ID)
 	if err != nil {
-		log.Error("failed get currency balance", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("failed get currency balance"))
+		log.Error("failed get currency balance", slog.String("error", err.Error()))
+		api.WriteError(w, r, err)
 		return
 	}
-	render.JSON(w, r, &CurrencyWalletResponse{
-		Rates: data.Balances,
+	resp := BalanceResponse{
+		Response: api.OK(),
+		Rates:    make(map[string]float32, len(data)),
+		Balances: data,
+	}
+	for currency, b := range data {
+		resp.Rates[currency] = b.Total
+	}
+	if req.Valuation != "" {
+		resp.Valuation, err = h.s.ValueBalances(r.Context(), data, req.Valuation)
+		if err != nil {
+			log.Error("failed to value balance", slog.String("error", err.Error()))
+			api.WriteError(w, r, err)
+			return
+		}
+	}
+	render.JSON(w, r, resp)
+}
+
+// @Summary BalanceHistory
+// @ID getBalanceHistory
+// @Tags wallet
+// @Description net worth of the current user in the reporting currency at the end of each day, from daily
+// @Description balance and rate snapshots. Defaults to the last 30 days, at most a year. Days without a snapshot are omitted
+// @Produce json
+// @Param valuation query string true "reporting currency" Enums(USD, EUR, RUB)
+// @Param from query string false "first day, YYYY-MM-DD"
+// @Param to query string false "last day, YYYY-MM-DD"
+// @Success 200 {object}  ValuationHistoryResponse
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /balance/history [get]
+func (h *Handler) BalanceHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Wallet.Handler.BalanceHistory"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	query := r.URL.Query()
+	req := ValuationHistoryRequest{
+		Valuation: query.Get("valuation"),
+		From:      query.Get("from"),
+		To:        query.Get("to"),
+	}
+	if err := request.Validate(&req); err != nil {
+		api.WriteError(w, r, err)
+		return
+	}
+	points, err := h.s.ValuationHistory(r.Context(), claims.ID, req)
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to get balance history", logger.Err(err))
+		}
+		api.WriteError(w, r, err)
+		return
+	}
+	render.JSON(w, r, ValuationHistoryResponse{
+		Response: api.OK(),
+		Currency: req.Valuation,
+		Points:   points,
 	})
 }
 
-// @Summary GetBalanceHandler
+// @Summary Deposit
+// @ID deposit
 // @Tags wallet
-// @Description balance user
+// @Description deposit to a currency wallet without a payment provider. Available only when
+// @Description payments.direct_deposit is enabled, otherwise use /payments/topups
 // @Accept json
 // @Produce json
 // @Param input body DepositOrWithdrawRequest true "deposit body"
 // @Success 200 {object}  DepositOrWithdrawResponse
-// @Failure 400,404 {object}  api.Response
+// @Failure 400,401,403,404,413 {object}  api.Response
 // @Failure 500 {object}  api.Response
-// @Security refreshToken
+// @Security AccessTokenCookie
 // @Router /deposit [post]
 func (h *Handler) DepositWallet(w http.ResponseWriter, r *http.Request) {
 	const op = "handlers.DepositWallet"
@@ -112,29 +195,20 @@ func (h *Handler) DepositWallet(w http.ResponseWriter, r *http.Request) {
 	)
 	userid, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
 	if err != nil {
-		log.Error("failed get user id from jwt", err.Error())
-		render.Status(r, http.StatusUnauthorized)
-		render.JSON(w, r, api.Error("Unauthorized"))
+		log.Error("failed get user id from jwt", slog.String("error", err.Error()))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
 		return
 	}
 	var req DepositOrWithdrawRequest
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		log.Error("failed to decode request body", slog.Any("err", err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("Ошибка при валидации данных"))
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		log.Error("invalid request", logger.Err(err))
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, api.Error("invalid request"))
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
 	data, err := h.s.WalletDepositOrWithDraw(r.Context(), userid.ID, req.Currency, req.Amount, contextkey.OperationTypeDeposit)
 	if err != nil {
-		log.Error("failed deposit currency wallet", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("failed deposit currency wallet"))
+		log.Error("failed deposit currency wallet", slog.String("error", err.Error()))
+		api.WriteError(w, r, err)
 		return
 	}
 	render.JSON(w, r, DepositOrWithdrawResponse{
@@ -143,16 +217,17 @@ func (h *Handler) DepositWallet(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// @Summary WithdrawWallet
+// @Summary Withdraw
+// @ID withdraw
 // @Tags wallet
-// @Description balance user
+// @Description withdraw from a currency wallet. Large amounts require totp_code when two-factor authentication is enabled
 // @Accept json
 // @Produce json
 // @Param input body DepositOrWithdrawRequest true "withdraw body"
 // @Success 200 {object}  DepositOrWithdrawResponse
-// @Failure 400,404 {object}  api.Response
+// @Failure 400,401,403,404,413,422 {object}  api.Response
 // @Failure 500 {object}  api.Response
-// @Security refreshToken
+// @Security AccessTokenCookie
 // @Router /withdraw [post]
 func (h *Handler) WithdrawWallet(w http.ResponseWriter, r *http.Request) {
 	const op = "handlers.WithdrawWallet"
@@ -162,29 +237,25 @@ func (h *Handler) WithdrawWallet(w http.ResponseWriter, r *http.Request) {
 	)
 	userid, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
 	if err != nil {
-		log.Error("failed get user id from jwt", err.Error())
-		render.Status(r, http.StatusUnauthorized)
-		render.JSON(w, r, api.Error("Unauthorized"))
+		log.Error("failed get user id from jwt", slog.String("error", err.Error()))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
 		return
 	}
 	var req DepositOrWithdrawRequest
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		log.Error("failed to decode request body", slog.Any("err", err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("Ошибка при валидации данных"))
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
-	if err := validator.New().Struct(req); err != nil {
-		log.Error("invalid request", logger.Err(err))
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, api.Error("invalid request"))
+	if err := h.s.AuthorizeWithdraw(r.Context(), userid.ID, req.Amount, req.Currency, req.TOTPCode); err != nil {
+		log.Error("failed to authorize withdraw", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
 	data, err := h.s.WalletDepositOrWithDraw(r.Context(), userid.ID, req.Currency, req.Amount, contextkey.OperationTypeWithdraw)
 	if err != nil {
-		log.Error("failed deposit currency wallet", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("failed deposit currency wallet"))
+		log.Error("failed withdraw currency wallet", slog.String("error", err.Error()))
+		api.WriteError(w, r, err)
 		return
 	}
 	log.Info("Successfully withdraw currency data")
@@ -194,16 +265,17 @@ func (h *Handler) WithdrawWallet(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// @Summary GetBalanceHandler
+// @Summary Exchange
+// @ID exchange
 // @Tags wallet
-// @Description balance user
+// @Description exchange amount of from_currency to to_currency at the current rate
 // @Accept json
 // @Produce json
-// @Param input body ExchangeRequest true "deposit body"
-// @Success 200 {object}  DepositOrWithdrawResponse
-// @Failure 400,404 {object}  api.Response
-// @Failure 500 {object}  api.Response
-// @Security refreshToken
+// @Param input body ExchangeRequest true "exchange body"
+// @Success 200 {object}  ExchangeResponse
+// @Failure 400,401,404,413,422 {object}  api.Response
+// @Failure 500,503 {object}  api.Response
+// @Security AccessTokenCookie
 // @Router /exchange [post]
 func (h *Handler) ExchangeWallet(w http.ResponseWriter, r *http.Request) {
 	const op = "handlers.ExchangeWallet"
@@ -213,43 +285,266 @@ func (h *Handler) ExchangeWallet(w http.ResponseWriter, r *http.Request) {
 	)
 	userid, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
 	if err != nil {
-		log.Error("failed get user id from jwt", err.Error())
-		render.Status(r, http.StatusUnauthorized)
-		render.JSON(w, r, api.Error("Unauthorized"))
+		log.Error("failed get user id from jwt", slog.String("error", err.Error()))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
 		return
 	}
 	var req ExchangeRequest
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		log.Error("failed to decode request body", slog.Any("err", err))
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("Ошибка при валидации данных"))
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		log.Error("invalid request", logger.Err(err))
-		render.Status(r, http.StatusBadRequest)
-		render.JSON(w, r, api.Error("invalid request"))
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
 		return
 	}
 	data, err := h.s.GetExchangeRateForCurrency(r.Context(), req.ToCurrency, req.FromCurrency)
 	if err != nil {
-		log.Error("failed get exchange rate", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("failed get exchange rate"))
+		log.Error("failed get exchange rate", slog.String("error", err.Error()))
+		api.WriteError(w, r, err)
+		return
 	}
 	tocurrency := req.Amount * data.Rate
-	dataexchanger, err := h.s.CurrencyExchangeWallet(r.Context(), userid.ID, req.ToCurrency, req.FromCurrency, tocurrency, req.Amount)
+	dataexchanger, err := h.s.CurrencyExchangeWallet(r.Context(), userid.ID, data, tocurrency, req.Amount)
 	if err != nil {
-		log.Error("failed CurrencyExchangeWallet", err.Error())
-		render.Status(r, http.StatusInternalServerError)
-		render.JSON(w, r, api.Error("Failed exchange balance"))
+		log.Error("failed CurrencyExchangeWallet", slog.String("error", err.Error()))
+		api.WriteError(w, r, err)
 		return
 	}
-	log.Info("request received exchange wallet", userid.ID)
+	log.Info("request received exchange wallet", slog.String("user_id", userid.ID.String()))
 	render.JSON(w, r, &ExchangeResponse{
 		Response:        api.OK(),
-		Message:         "Exchange successful",
-		ExchangedAmount: data.Rate,
+		Message:         i18n.Message(i18n.FromContext(r.Context()), "exchange_successful"),
+		ExchangedAmount: tocurrency,
 		NewBalance:      dataexchanger.Balances,
 	})
 }
+
+// writeError отвечает доменной ошибкой, в лог пишутся только непредвиденные ошибки.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error) {
+	if utils.AsError(err).Kind == utils.KindInternal {
+		log.Error("request failed", logger.Err(err))
+	}
+	api.WriteError(w, r, err)
+}
+
+// @Summary AuthorizeHold
+// @ID authorizeHold
+// @Tags holds
+// @Description reserve amount on a currency wallet without charging it. The held amount is not available
+// @Description for withdrawals, exchanges and transfers until the hold is captured, voided or expires.
+// @Description reference is the caller's operation id, a second hold with the same reference is rejected.
+// @Description Large amounts require totp_code
+// @Accept json
+// @Produce json
+// @Param input body AuthorizeHoldRequest true "hold"
+// @Success 201 {object}  HoldResponse
+// @Failure 400,401,403,404,409,413,422 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /holds [post]
+func (h *Handler) AuthorizeHoldHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Wallet.Handler.AuthorizeHold"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var req AuthorizeHoldRequest
+	if err := request.Decode(w, r, &req); err != nil {
+		log.Warn("invalid request", logger.Err(err))
+		api.WriteError(w, r, err)
+		return
+	}
+	hold, err := h.s.AuthorizeHold(r.Context(), claims.ID, req)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, HoldResponse{
+		Response: api.OK(),
+		Hold:     NewHold(hold),
+	})
+}
+
+// @Summary CaptureHold
+// @ID captureHold
+// @Tags holds
+// @Description charge an authorized hold. Without amount the whole hold is captured,
+// @Description on partial capture the rest is released
+// @Accept json
+// @Produce json
+// @Param id path string true "hold id"
+// @Param input body CaptureHoldRequest false "capture"
+// @Success 200 {object}  HoldResponse
+// @Failure 400,401,404,409,413,422 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /holds/{id}/capture [post]
+func (h *Handler) CaptureHoldHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Wallet.Handler.CaptureHold"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidHoldID)
+		return
+	}
+	var req CaptureHoldRequest
+	if r.ContentLength != 0 {
+		if err := request.Decode(w, r, &req); err != nil {
+			log.Warn("invalid request", logger.Err(err))
+			api.WriteError(w, r, err)
+			return
+		}
+	}
+	hold, err := h.s.CaptureHold(r.Context(), claims.ID, id, req.Amount)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, HoldResponse{
+		Response: api.OK(),
+		Hold:     NewHold(hold),
+	})
+}
+
+// @Summary VoidHold
+// @ID voidHold
+// @Tags holds
+// @Description cancel an authorized hold, the amount becomes available again
+// @Produce json
+// @Param id path string true "hold id"
+// @Success 200 {object}  HoldResponse
+// @Failure 400,401,404,409 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /holds/{id}/void [post]
+func (h *Handler) VoidHoldHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Wallet.Handler.VoidHold"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidHoldID)
+		return
+	}
+	hold, err := h.s.VoidHold(r.Context(), claims.ID, id)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, HoldResponse{
+		Response: api.OK(),
+		Hold:     NewHold(hold),
+	})
+}
+
+// @Summary GetHold
+// @ID getHold
+// @Tags holds
+// @Description hold of the current user
+// @Produce json
+// @Param id path string true "hold id"
+// @Success 200 {object}  HoldResponse
+// @Failure 400,401,404 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /holds/{id} [get]
+func (h *Handler) GetHoldHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Wallet.Handler.GetHold"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		api.WriteError(w, r, utils.ErrorInvalidHoldID)
+		return
+	}
+	hold, err := h.s.GetHold(r.Context(), claims.ID, id)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	render.JSON(w, r, HoldResponse{
+		Response: api.OK(),
+		Hold:     NewHold(hold),
+	})
+}
+
+// @Summary ListHolds
+// @ID listHolds
+// @Tags holds
+// @Description holds of the current user, newest first
+// @Produce json
+// @Param status query string false "filter by status" Enums(AUTHORIZED, CAPTURED, VOIDED, EXPIRED)
+// @Success 200 {object}  HoldsResponse
+// @Failure 400,401 {object}  api.Response
+// @Failure 500 {object}  api.Response
+// @Security AccessTokenCookie
+// @Router /holds [get]
+func (h *Handler) ListHoldsHandler(w http.ResponseWriter, r *http.Request) {
+	const op = "Wallet.Handler.ListHolds"
+	log := h.log.With(
+		slog.String("op", op),
+		slog.String("request_id", middleware.GetReqID(r.Context())),
+	)
+	claims, err := httphandlers.GetJWTClaimsFromCtx(r.Context())
+	if err != nil {
+		log.Error("failed get user id from jwt", logger.Err(err))
+		api.WriteError(w, r, utils.ErrorUnauthorized)
+		return
+	}
+	var status *HoldStatus
+	if v := r.URL.Query().Get("status"); v != "" {
+		st := HoldStatus(v)
+		switch st {
+		case HoldAuthorized, HoldCaptured, HoldVoided, HoldExpired:
+			status = &st
+		default:
+			api.WriteError(w, r, &api.ValidationError{Fields: []api.FieldError{{
+				Field: "status", Rule: "oneof", Param: "AUTHORIZED CAPTURED VOIDED EXPIRED",
+			}}})
+			return
+		}
+	}
+	holds, err := h.s.ListHolds(r.Context(), claims.ID, status)
+	if err != nil {
+		h.writeError(w, r, log, err)
+		return
+	}
+	resp := HoldsResponse{
+		Response: api.OK(),
+		Holds:    make([]Hold, 0, len(holds)),
+	}
+	for _, hold := range holds {
+		resp.Holds = append(resp.Holds, NewHold(hold))
+	}
+	render.JSON(w, r, resp)
+}
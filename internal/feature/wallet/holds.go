@@ -0,0 +1,280 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+type HoldOptions struct {
+	DefaultTTL time.Duration
+	MaxTTL     time.Duration
+}
+
+// AuthorizeHold резервирует сумму на кошельке: баланс не меняется, но доступный остаток уменьшается.
+func (s *Service) AuthorizeHold(ctx context.Context, userID uuid.UUID, req AuthorizeHoldRequest) (*HoldDB, error) {
+	const op = "Wallet.Service.AuthorizeHold"
+	log := s.log.With(slog.String("op", op))
+
+	if !contextkey.IsKnownCurrency(req.Currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	now := time.Now().UTC()
+	expiresAt := now.Add(s.holds.DefaultTTL)
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.UTC()
+	}
+	if !expiresAt.After(now) || expiresAt.After(now.Add(s.holds.MaxTTL)) {
+		return nil, utils.ErrorHoldExpiryInvalid
+	}
+	verified, err := s.users.IsEmailVerified(ctx, userID)
+	if err != nil {
+		log.Error("failed to check email verification", logger.Err(err))
+		return nil, err
+	}
+	if !verified {
+		return nil, utils.ErrorEmailNotVerified
+	}
+	if err := s.users.RequireStepUp(ctx, userID, req.Amount, req.Currency, req.TOTPCode); err != nil {
+		return nil, err
+	}
+
+	var hold *HoldDB
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		walletID, err := s.repository.PlaceHold(ctx, userID, req.Currency, req.Amount)
+		if err != nil {
+			return err
+		}
+		hold, err = s.repository.CreateHold(ctx, &HoldDB{
+			UserID:    userID,
+			WalletID:  walletID,
+			Currency:  req.Currency,
+			Amount:    req.Amount,
+			Reference: req.Reference,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		return s.emitHold(ctx, hold, EventTypeHoldAuthorized, NotificationHoldAuthorized)
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to authorize hold", logger.Err(err))
+		}
+		return nil, err
+	}
+	return hold, nil
+}
+
+// CaptureHold превращает холд в списание. amount не больше суммы холда, остаток освобождается.
+func (s *Service) CaptureHold(ctx context.Context, userID, id uuid.UUID, amount *float32) (*HoldDB, error) {
+	const op = "Wallet.Service.CaptureHold"
+	log := s.log.With(slog.String("op", op))
+
+	var hold *HoldDB
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		h, err := s.lockOpenHold(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		before := NewHold(h)
+		captured := h.Amount
+		if amount != nil {
+			if *amount > h.Amount {
+				return utils.ErrorCaptureExceedsHold
+			}
+			captured = *amount
+		}
+		if err := s.repository.ReleaseHold(ctx, h.WalletID, h.Amount, captured); err != nil {
+			return err
+		}
+		if err := s.repository.SetTransaction(ctx, h.WalletID, captured, contextkey.OperationTypeWithdraw, nil, "hold:"+h.ID.String()+":capture"); err != nil {
+			return err
+		}
+		h.CapturedAmount = &captured
+		if err := s.closeHold(ctx, h, HoldCaptured); err != nil {
+			return err
+		}
+		hold = h
+		if err := s.emitHold(ctx, h, EventTypeHoldCaptured, NotificationHoldCaptured); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionHoldCapture,
+			ActorID:  &userID,
+			TargetID: &userID,
+			Before:   before,
+			After:    NewHold(h),
+		})
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to capture hold", logger.Err(err))
+		}
+		return nil, err
+	}
+	return hold, nil
+}
+
+// VoidHold отменяет холд и возвращает сумму в доступный остаток.
+func (s *Service) VoidHold(ctx context.Context, userID, id uuid.UUID) (*HoldDB, error) {
+	const op = "Wallet.Service.VoidHold"
+	log := s.log.With(slog.String("op", op))
+
+	var hold *HoldDB
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		h, err := s.lockOpenHold(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		before := NewHold(h)
+		if err := s.repository.ReleaseHold(ctx, h.WalletID, h.Amount, 0); err != nil {
+			return err
+		}
+		if err := s.closeHold(ctx, h, HoldVoided); err != nil {
+			return err
+		}
+		hold = h
+		if err := s.emitHold(ctx, h, EventTypeHoldVoided, NotificationHoldVoided); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionHoldVoid,
+			ActorID:  &userID,
+			TargetID: &userID,
+			Before:   before,
+			After:    NewHold(h),
+		})
+	})
+	if err != nil {
+		if utils.AsError(err).Kind == utils.KindInternal {
+			log.Error("failed to void hold", logger.Err(err))
+		}
+		return nil, err
+	}
+	return hold, nil
+}
+
+func (s *Service) GetHold(ctx context.Context, userID, id uuid.UUID) (*HoldDB, error) {
+	return s.repository.GetHold(ctx, id, userID)
+}
+
+func (s *Service) ListHolds(ctx context.Context, userID uuid.UUID, status *HoldStatus) ([]*HoldDB, error) {
+	return s.repository.ListHolds(ctx, userID, status)
+}
+
+// StartHoldExpiry по тикеру освобождает холды с истёкшим сроком.
+func (s *Service) StartHoldExpiry(ctx context.Context, handlePeriod time.Duration, limit uint64) {
+	const op = "Wallet.Service.StartHoldExpiry"
+
+	log := s.log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping hold expiry")
+				return
+
+			case <-ticker.C:
+				var count int
+				err := s.tx.Do(ctx, func(ctx context.Context) error {
+					holds, err := s.repository.LockExpiredHolds(ctx, time.Now().UTC(), limit)
+					if err != nil {
+						return err
+					}
+					for _, h := range holds {
+						before := NewHold(h)
+						if err := s.repository.ReleaseHold(ctx, h.WalletID, h.Amount, 0); err != nil {
+							return err
+						}
+						if err := s.closeHold(ctx, h, HoldExpired); err != nil {
+							return err
+						}
+						if err := s.emitHold(ctx, h, EventTypeHoldExpired, NotificationHoldExpired); err != nil {
+							return err
+						}
+						// холд освобождает воркер, исполнитель в журнале — system
+						if err := s.audit.Record(ctx, audit.Entry{
+							Action:   audit.ActionHoldExpire,
+							TargetID: &h.UserID,
+							Before:   before,
+							After:    NewHold(h),
+						}); err != nil {
+							return err
+						}
+					}
+					count = len(holds)
+					return nil
+				})
+				if err != nil {
+					log.Error("failed to expire holds", logger.Err(err))
+					continue
+				}
+				if count > 0 {
+					log.Info("expired holds", slog.Int("count", count))
+				}
+			}
+		}
+	}()
+}
+
+// lockOpenHold блокирует холд, который ещё можно списать или отменить.
+// Холд с наступившим сроком считается истёкшим, даже если воркер его ещё не обработал.
+func (s *Service) lockOpenHold(ctx context.Context, id, userID uuid.UUID) (*HoldDB, error) {
+	h, err := s.repository.LockHold(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if h.Status != HoldAuthorized || !h.ExpiresAt.After(time.Now().UTC()) {
+		return nil, utils.ErrorHoldClosed
+	}
+	return h, nil
+}
+
+func (s *Service) closeHold(ctx context.Context, h *HoldDB, status HoldStatus) error {
+	now := time.Now().UTC()
+	h.Status = status
+	h.ClosedAt = &now
+	return s.repository.CloseHold(ctx, h)
+}
+
+func (s *Service) emitHold(ctx context.Context, h *HoldDB, eventType, code string) error {
+	locale, err := s.users.NotificationLocale(ctx, h.UserID)
+	if err != nil {
+		return err
+	}
+	var captured float32
+	if h.CapturedAmount != nil {
+		captured = *h.CapturedAmount
+	}
+	payload, err := json.Marshal(KafkaPayloadHold{
+		HoldID:         h.ID,
+		UserID:         h.UserID,
+		Status:         h.Status,
+		Currency:       h.Currency,
+		Amount:         h.Amount,
+		CapturedAmount: h.CapturedAmount,
+		Reference:      h.Reference,
+		Code:           code,
+		Locale:         string(locale),
+		Message:        i18n.Message(locale, code, h.Amount, h.Currency, captured),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.events.CreateEvent(ctx, eventType, string(payload))
+	return err
+}
@@ -0,0 +1,162 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// fakeTx — транзакция в контексте: репозиторий поддельный, её методы не вызываются.
+type fakeTx struct{ pgx.Tx }
+
+// fakeHolds хранит один кошелёк и его холды в памяти: balance включает held, доступно balance - held.
+// Остальные методы ServiceWallets тестам холдов не нужны.
+type fakeHolds struct {
+	ServiceWallets
+	walletID     uuid.UUID
+	balance      float32
+	held         float32
+	holds        map[uuid.UUID]*HoldDB
+	transactions []float32
+	events       []string
+	audited      []audit.Action
+}
+
+func (r *fakeHolds) PlaceHold(_ context.Context, _ uuid.UUID, _ string, amount float32) (uuid.UUID, error) {
+	if r.balance-r.held < amount {
+		return uuid.Nil, utils.ErrorInsufficientFunds
+	}
+	r.held += amount
+	return r.walletID, nil
+}
+
+func (r *fakeHolds) ReleaseHold(_ context.Context, _ uuid.UUID, held, captured float32) error {
+	r.held -= held
+	r.balance -= captured
+	return nil
+}
+
+func (r *fakeHolds) CreateHold(_ context.Context, h *HoldDB) (*HoldDB, error) {
+	h.ID = uuid.New()
+	h.Status = HoldAuthorized
+	r.holds[h.ID] = h
+	return h, nil
+}
+
+func (r *fakeHolds) LockHold(_ context.Context, id, _ uuid.UUID) (*HoldDB, error) {
+	h, ok := r.holds[id]
+	if !ok {
+		return nil, utils.ErrorHoldNotFound
+	}
+	return h, nil
+}
+
+func (r *fakeHolds) CloseHold(_ context.Context, h *HoldDB) error {
+	r.holds[h.ID] = h
+	return nil
+}
+
+func (r *fakeHolds) SetTransaction(_ context.Context, _ uuid.UUID, amount float32, typetransaction contextkey.OperationType, _ *uuid.UUID, _ string) error {
+	if typetransaction != contextkey.OperationTypeWithdraw {
+		return errors.New("capture must be recorded as a withdrawal")
+	}
+	r.transactions = append(r.transactions, amount)
+	return nil
+}
+
+func (r *fakeHolds) IsEmailVerified(context.Context, uuid.UUID) (bool, error) { return true, nil }
+
+func (r *fakeHolds) RequireStepUp(context.Context, uuid.UUID, float32, string, string) error {
+	return nil
+}
+
+func (r *fakeHolds) NotificationLocale(context.Context, uuid.UUID) (i18n.Locale, error) {
+	return i18n.Default, nil
+}
+
+func (r *fakeHolds) CreateEvent(_ context.Context, eventType, _ string) (uuid.UUID, error) {
+	r.events = append(r.events, eventType)
+	return uuid.New(), nil
+}
+
+func (r *fakeHolds) Record(_ context.Context, e audit.Entry) error {
+	r.audited = append(r.audited, e.Action)
+	return nil
+}
+
+// TestCaptureHold — списать можно не больше суммы холда и только пока он действует: холд с наступившим
+// сроком считается истёкшим, даже если воркер его ещё не закрыл. Отклонённое списание ничего не меняет.
+func TestCaptureHold(t *testing.T) {
+	amount := func(v float32) *float32 { return &v }
+	cases := []struct {
+		name         string
+		status       HoldStatus
+		expiresIn    time.Duration
+		capture      *float32
+		wantErr      error
+		wantBalance  float32
+		wantHeld     float32
+		wantCaptured float32
+	}{
+		{"full amount", HoldAuthorized, time.Hour, nil, nil, 40, 0, 60},
+		{"partial", HoldAuthorized, time.Hour, amount(20), nil, 80, 0, 20},
+		{"exactly the hold", HoldAuthorized, time.Hour, amount(60), nil, 40, 0, 60},
+		{"more than the hold", HoldAuthorized, time.Hour, amount(60.01), utils.ErrorCaptureExceedsHold, 100, 60, 0},
+		{"after expiry", HoldAuthorized, -time.Second, nil, utils.ErrorHoldClosed, 100, 60, 0},
+		{"partial after expiry", HoldAuthorized, -time.Second, amount(10), utils.ErrorHoldClosed, 100, 60, 0},
+		{"already voided", HoldVoided, time.Hour, nil, utils.ErrorHoldClosed, 100, 60, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			userID := uuid.New()
+			r := &fakeHolds{walletID: uuid.New(), balance: 100, held: 60, holds: map[uuid.UUID]*HoldDB{}}
+			h, err := r.CreateHold(context.Background(), &HoldDB{
+				UserID:    userID,
+				WalletID:  r.walletID,
+				Currency:  "USD",
+				Amount:    60,
+				ExpiresAt: time.Now().Add(c.expiresIn),
+			})
+			if err != nil {
+				t.Fatalf("create hold: %v", err)
+			}
+			h.Status = c.status
+			s := NewService(r, r, r, r, nil, nil, nil, nil, HoldOptions{}, false, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			_, err = s.CaptureHold(db.WithTx(context.Background(), fakeTx{}), userID, h.ID, c.capture)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("CaptureHold: %v, want %v", err, c.wantErr)
+			}
+			if r.balance != c.wantBalance || r.held != c.wantHeld {
+				t.Errorf("balance %v (held %v), want %v (held %v)", r.balance, r.held, c.wantBalance, c.wantHeld)
+			}
+			if c.wantErr != nil {
+				if h.Status != c.status || len(r.transactions) != 0 || len(r.events) != 0 || len(r.audited) != 0 {
+					t.Errorf("rejected capture changed the hold: status %s, transactions %v, events %v, audit %v",
+						h.Status, r.transactions, r.events, r.audited)
+				}
+				return
+			}
+			if h.Status != HoldCaptured || h.CapturedAmount == nil || *h.CapturedAmount != c.wantCaptured {
+				t.Errorf("hold %s captured %v, want CAPTURED %v", h.Status, h.CapturedAmount, c.wantCaptured)
+			}
+			if len(r.transactions) != 1 || r.transactions[0] != c.wantCaptured {
+				t.Errorf("transactions = %v, want one withdrawal of %v", r.transactions, c.wantCaptured)
+			}
+			if len(r.audited) != 1 || r.audited[0] != audit.ActionHoldCapture {
+				t.Errorf("audit = %v, want %s", r.audited, audit.ActionHoldCapture)
+			}
+		})
+	}
+}
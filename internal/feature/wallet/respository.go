@@ -6,31 +6,41 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
 	"github.com/Sanchir01/currency-wallet/internal/domain/models"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
 	"github.com/Sanchir01/currency-wallet/pkg/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"log/slog"
+	"time"
 )
 
 type Repository struct {
 	primaryDB *pgxpool.Pool
+	reads     *db.Router
 	log       *slog.Logger
 }
 
-func NewRepository(primaryDB *pgxpool.Pool, log *slog.Logger) *Repository {
+// NewRepository — reads направляет часть чтений на реплики, без него все запросы идут в primaryDB.
+func NewRepository(primaryDB *pgxpool.Pool, reads *db.Router, log *slog.Logger) *Repository {
 	return &Repository{
 		primaryDB: primaryDB,
+		reads:     reads,
 		log:       log,
 	}
 }
 
-func (r *Repository) Balance(ctx context.Context, id uuid.UUID) (*models.CurrencyWallet, error) {
-	conn, err := r.primaryDB.Acquire(ctx)
-	if err != nil {
-		return nil, err
+// reader — через что читать: транзакция из ctx, реплика или основная база.
+func (r *Repository) reader(ctx context.Context) db.Querier {
+	if r.reads == nil {
+		return db.Conn(ctx, r.primaryDB)
 	}
-	defer conn.Release()
+	return r.reads.Reader(ctx)
+}
+
+func (r *Repository) Balance(ctx context.Context, id uuid.UUID) (*models.CurrencyWallet, error) {
+	conn := r.reader(ctx)
 	query, args, err := sq.Select("balance, currency").
 		From("wallets").
 		Where(sq.Eq{"user_id": id}).
@@ -66,7 +76,8 @@ func (r *Repository) Balance(ctx context.Context, id uuid.UUID) (*models.Currenc
 	}), nil
 }
 
-func (r *Repository) CreateManyWallets(ctx context.Context, userID uuid.UUID, tx pgx.Tx) error {
+func (r *Repository) CreateManyWallets(ctx context.Context, userID uuid.UUID) error {
+	conn := db.Conn(ctx, r.primaryDB)
 	builder := sq.Insert("wallets").
 		Columns("user_id", "currency", "balance").
 		PlaceholderFormat(sq.Dollar)
@@ -79,7 +90,7 @@ func (r *Repository) CreateManyWallets(ctx context.Context, userID uuid.UUID, tx
 		return utils.ErrorQueryString
 	}
 
-	_, err = tx.Exec(ctx, query, args...)
+	_, err = conn.Exec(ctx, query, args...)
 	if err != nil {
 		slog.Error("failed to insert wallets", slog.Any("err", err))
 		return err
@@ -92,21 +103,22 @@ func (r *Repository) DepositOrWithdrawBalance(
 	id uuid.UUID,
 	amount float32,
 	currency string,
-	tx pgx.Tx,
 	typedepo contextkey.OperationType,
 ) (*models.CurrencyWalletDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
 	var query string
-	r.log.Warn("depo props", amount)
+	r.log.Debug("depo props", slog.Any("amount", amount))
 
+	// основной запрос видит снимок до UPDATE, поэтому баланс изменённого кошелька берётся из RETURNING
 	if typedepo == contextkey.OperationTypeWithdraw {
 		query = `
 		WITH updated_wallet AS (
 			UPDATE wallets
 			SET balance = balance - $1
-			WHERE user_id = $2 AND currency = $3 AND balance >= $1
-			RETURNING id, user_id
+			WHERE user_id = $2 AND currency = $3 AND balance - held >= $1
+			RETURNING id, user_id, balance
 		)
-		SELECT w.id, w.currency, w.balance
+		SELECT w.id, w.currency, CASE WHEN w.id = uw.id THEN uw.balance ELSE w.balance END, uw.id
 		FROM wallets w
 		JOIN updated_wallet uw ON w.user_id = uw.user_id;
 		`
@@ -116,9 +128,9 @@ func (r *Repository) DepositOrWithdrawBalance(
 			UPDATE wallets
 			SET balance = balance + $1
 			WHERE user_id = $2 AND currency = $3
-			RETURNING id, user_id
+			RETURNING id, user_id, balance
 		)
-		SELECT w.id, w.currency, w.balance
+		SELECT w.id, w.currency, CASE WHEN w.id = uw.id THEN uw.balance ELSE w.balance END, uw.id
 		FROM wallets w
 		JOIN updated_wallet uw ON w.user_id = uw.user_id;
 		`
@@ -126,34 +138,35 @@ func (r *Repository) DepositOrWithdrawBalance(
 
 	args := []interface{}{amount, id, currency}
 
-	rows, err := tx.Query(ctx, query, args...)
+	rows, err := conn.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	balances := make(map[string]float32)
+	// walletID — кошелёк, баланс которого изменился, а не первый попавшийся кошелёк пользователя
 	var walletID uuid.UUID
-	first := true
 
 	for rows.Next() {
 		var currency string
 		var balance float32
 		var currentWalletID uuid.UUID
 
-		if err := rows.Scan(&currentWalletID, &currency, &balance); err != nil {
+		if err := rows.Scan(&currentWalletID, &currency, &balance, &walletID); err != nil {
 			return nil, err
 		}
 		balances[currency] = balance
-
-		if first {
-			walletID = currentWalletID
-			first = false
-		}
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	if len(balances) == 0 {
-		return nil, errors.New("insufficient funds or wallet not found")
+		if typedepo != contextkey.OperationTypeWithdraw {
+			return nil, utils.ErrorWalletNotFound
+		}
+		return nil, r.debitFailure(ctx, id, currency)
 	}
 
 	return &models.CurrencyWalletDB{
@@ -164,31 +177,400 @@ func (r *Repository) DepositOrWithdrawBalance(
 	}, nil
 }
 
+// debitFailure объясняет, почему списание не прошло: либо кошелька нет, либо на нём не хватает доступных средств.
+func (r *Repository) debitFailure(ctx context.Context, id uuid.UUID, currency string) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	var exists bool
+	if err := conn.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM wallets WHERE user_id = $1 AND currency = $2)", id, currency,
+	).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return utils.ErrorWalletNotFound
+	}
+	return utils.ErrorInsufficientFunds
+}
+
+// SetTransaction записывает операцию в журнал. Непустой idempotencyKey уникален:
+// повтор той же операции возвращает utils.ErrorDuplicateOperation.
 func (r *Repository) SetTransaction(ctx context.Context, walletID uuid.UUID,
 	amount float32,
 	typetransaction contextkey.OperationType,
 	senderID *uuid.UUID,
-	tx pgx.Tx) error {
-	queryBuilder := sq.Insert("transactions").
-		Columns("wallet_id", "amount", "type").
-		Values(walletID, amount, typetransaction).
-		PlaceholderFormat(sq.Dollar)
+	idempotencyKey string) error {
+	return r.setTransaction(ctx, walletID, amount, typetransaction, senderID, idempotencyKey, nil)
+}
+
+// SetExchangeTransaction записывает операцию обмена со ссылкой на снимок курса, по которому она прошла.
+func (r *Repository) SetExchangeTransaction(ctx context.Context, walletID uuid.UUID,
+	amount float32,
+	typetransaction contextkey.OperationType,
+	idempotencyKey string,
+	rate *ExchangeRateToCurrency) error {
+	return r.setTransaction(ctx, walletID, amount, typetransaction, nil, idempotencyKey, rate)
+}
 
+func (r *Repository) setTransaction(ctx context.Context, walletID uuid.UUID,
+	amount float32,
+	typetransaction contextkey.OperationType,
+	senderID *uuid.UUID,
+	idempotencyKey string,
+	rate *ExchangeRateToCurrency) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	columns := []string{"wallet_id", "amount", "type"}
+	values := []any{walletID, amount, typetransaction}
 	if senderID != nil {
-		queryBuilder = sq.Insert("transactions").
-			Columns("wallet_id", "amount", "type", "sender_wallet_id").
-			Values(walletID, amount, typetransaction, *senderID).
-			PlaceholderFormat(sq.Dollar)
+		columns = append(columns, "sender_wallet_id")
+		values = append(values, *senderID)
 	}
-
-	query, args, err := queryBuilder.ToSql()
+	if idempotencyKey != "" {
+		columns = append(columns, "idempotency_key")
+		values = append(values, idempotencyKey)
+	}
+	if rate != nil && rate.SnapshotID != uuid.Nil {
+		columns = append(columns, "rate_snapshot_id", "rate_captured_at")
+		values = append(values, rate.SnapshotID, rate.CapturedAt)
+	}
+	query, args, err := sq.Insert("transactions").
+		Columns(columns...).
+		Values(values...).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
 		return utils.ErrorQueryString
 	}
 
-	if _, err = tx.Exec(ctx, query, args...); err != nil {
+	if _, err = conn.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return utils.ErrorDuplicateOperation
+		}
 		return err
 	}
 
 	return nil
 }
+
+// BalanceDetails возвращает по каждой валюте общий баланс, сумму под холдами и доступный остаток.
+func (r *Repository) BalanceDetails(ctx context.Context, id uuid.UUID) (map[string]Balance, error) {
+	conn := r.reader(ctx)
+	query, args, err := sq.Select("currency, balance, held").
+		From("wallets").
+		Where(sq.Eq{"user_id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	balances := make(map[string]Balance)
+	for rows.Next() {
+		var currency string
+		var b Balance
+		if err := rows.Scan(&currency, &b.Total, &b.Held); err != nil {
+			return nil, err
+		}
+		b.Available = b.Total - b.Held
+		balances[currency] = b
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return balances, nil
+}
+
+// PlaceHold резервирует amount на кошельке currency, если доступного остатка хватает.
+func (r *Repository) PlaceHold(ctx context.Context, userID uuid.UUID, currency string, amount float32) (uuid.UUID, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Update("wallets").
+		Set("held", sq.Expr("held + ?", amount)).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "currency": currency}).
+		Where(sq.Expr("balance - held >= ?", amount)).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, utils.ErrorQueryString
+	}
+	var walletID uuid.UUID
+	if err := conn.QueryRow(ctx, query, args...).Scan(&walletID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, r.debitFailure(ctx, userID, currency)
+		}
+		return uuid.Nil, err
+	}
+	return walletID, nil
+}
+
+// ReleaseHold снимает резерв held с кошелька и списывает captured из баланса (0 — без списания).
+func (r *Repository) ReleaseHold(ctx context.Context, walletID uuid.UUID, held, captured float32) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Update("wallets").
+		Set("held", sq.Expr("held - ?", held)).
+		Set("balance", sq.Expr("balance - ?", captured)).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": walletID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorWalletNotFound
+	}
+	return nil
+}
+
+const holdColumns = "id, user_id, wallet_id, currency, amount, captured_amount, status, reference, expires_at, closed_at, created_at"
+
+func scanHold(row pgx.Row) (*HoldDB, error) {
+	var h HoldDB
+	if err := row.Scan(&h.ID, &h.UserID, &h.WalletID, &h.Currency, &h.Amount, &h.CapturedAmount, &h.Status,
+		&h.Reference, &h.ExpiresAt, &h.ClosedAt, &h.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func scanHolds(rows pgx.Rows) ([]*HoldDB, error) {
+	defer rows.Close()
+	holds := make([]*HoldDB, 0)
+	for rows.Next() {
+		h, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+		holds = append(holds, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+func (r *Repository) CreateHold(ctx context.Context, h *HoldDB) (*HoldDB, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Insert("holds").
+		Columns("user_id", "wallet_id", "currency", "amount", "reference", "expires_at").
+		Values(h.UserID, h.WalletID, h.Currency, h.Amount, h.Reference, h.ExpiresAt).
+		Suffix("RETURNING " + holdColumns).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	created, err := scanHold(conn.QueryRow(ctx, query, args...))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, utils.ErrorDuplicateOperation
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *Repository) GetHold(ctx context.Context, id, userID uuid.UUID) (*HoldDB, error) {
+	conn := r.reader(ctx)
+	query, args, err := sq.Select(holdColumns).
+		From("holds").
+		Where(sq.Eq{"id": id, "user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	h, err := scanHold(conn.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorHoldNotFound
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+func (r *Repository) ListHolds(ctx context.Context, userID uuid.UUID, status *HoldStatus) ([]*HoldDB, error) {
+	conn := r.reader(ctx)
+	builder := sq.Select(holdColumns).
+		From("holds").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(sq.Dollar)
+	if status != nil {
+		builder = builder.Where(sq.Eq{"status": *status})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanHolds(rows)
+}
+
+// LockHold блокирует холд пользователя до конца транзакции.
+func (r *Repository) LockHold(ctx context.Context, id, userID uuid.UUID) (*HoldDB, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(holdColumns).
+		From("holds").
+		Where(sq.Eq{"id": id, "user_id": userID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	h, err := scanHold(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.ErrorHoldNotFound
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+func (r *Repository) LockExpiredHolds(ctx context.Context, now time.Time, limit uint64) ([]*HoldDB, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(holdColumns).
+		From("holds").
+		Where(sq.Eq{"status": HoldAuthorized}).
+		Where(sq.LtOrEq{"expires_at": now}).
+		OrderBy("expires_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanHolds(rows)
+}
+
+// CloseHold переводит авторизованный холд в конечное состояние.
+func (r *Repository) CloseHold(ctx context.Context, h *HoldDB) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Update("holds").
+		Set("status", h.Status).
+		Set("captured_amount", h.CapturedAmount).
+		Set("closed_at", h.ClosedAt).
+		Where(sq.Eq{"id": h.ID, "status": HoldAuthorized}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utils.ErrorHoldClosed
+	}
+	return nil
+}
+
+// SaveBalanceSnapshots записывает остатки всех кошельков на день day, повторный снимок за тот же день перезаписывает прежний.
+func (r *Repository) SaveBalanceSnapshots(ctx context.Context, day time.Time) (int64, error) {
+	conn := db.Conn(ctx, r.primaryDB)
+	query, args, err := sq.Insert("balance_snapshots").
+		Columns("day", "wallet_id", "user_id", "currency", "balance").
+		Select(sq.Select().
+			Column(sq.Expr("?::DATE", day)).
+			Columns("id", "user_id", "currency", "COALESCE(balance, 0)").
+			From("wallets")).
+		Suffix("ON CONFLICT (day, wallet_id) DO UPDATE SET balance = EXCLUDED.balance, taken_at = CURRENT_TIMESTAMP").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, utils.ErrorQueryString
+	}
+	tag, err := conn.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// SaveRateSnapshots записывает курсы валют на день day.
+func (r *Repository) SaveRateSnapshots(ctx context.Context, day time.Time, rates map[string]float64) error {
+	conn := db.Conn(ctx, r.primaryDB)
+	builder := sq.Insert("rate_snapshots").
+		Columns("day", "currency", "rate").
+		Suffix("ON CONFLICT (day, currency) DO UPDATE SET rate = EXCLUDED.rate, taken_at = CURRENT_TIMESTAMP").
+		PlaceholderFormat(sq.Dollar)
+	for currency, rate := range rates {
+		builder = builder.Values(day, currency, rate)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return utils.ErrorQueryString
+	}
+	_, err = conn.Exec(ctx, query, args...)
+	return err
+}
+
+// ValuationHistory пересчитывает дневные снимки балансов в валюту currency по курсам того же дня.
+// Дни без снимка курсов пропускаются.
+func (r *Repository) ValuationHistory(ctx context.Context, userID uuid.UUID, currency string, from, to time.Time) ([]ValuationPoint, error) {
+	conn := r.reader(ctx)
+	query, args, err := sq.
+		Select(
+			"to_char(b.day, 'YYYY-MM-DD')",
+			"ROUND(SUM(b.balance::NUMERIC * (v.rate / r.rate)::NUMERIC), 2)::DOUBLE PRECISION",
+		).
+		From("balance_snapshots b").
+		Join("rate_snapshots r ON r.day = b.day AND r.currency = b.currency").
+		Join("rate_snapshots v ON v.day = b.day AND v.currency = ?", currency).
+		Where(sq.Eq{"b.user_id": userID}).
+		Where(sq.GtOrEq{"b.day": from}).
+		Where(sq.LtOrEq{"b.day": to}).
+		GroupBy("b.day").
+		OrderBy("b.day").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, utils.ErrorQueryString
+	}
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	points := make([]ValuationPoint, 0)
+	for rows.Next() {
+		var p ValuationPoint
+		if err := rows.Scan(&p.Date, &p.Total); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
@@ -3,29 +3,26 @@ package wallet
 import (
 	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"log/slog"
 	"time"
 
 	"github.com/Sanchir01/currency-wallet/internal/domain/models"
-	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/rate"
 	"github.com/redis/go-redis/v9"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type ServiceWallets interface {
-	Balance(ctx context.Context, id uuid.UUID) (*models.CurrencyWallet, error)
 	DepositOrWithdrawBalance(
 		ctx context.Context,
 		id uuid.UUID,
 		amount float32,
 		currency string,
-		tx pgx.Tx,
 		typedepo contextkey.OperationType,
 	) (*models.CurrencyWalletDB, error)
 	SetTransaction(
@@ -34,35 +31,95 @@ type ServiceWallets interface {
 		amount float32,
 		typetransaction contextkey.OperationType,
 		senderID *uuid.UUID,
-		tx pgx.Tx,
+		idempotencyKey string,
 	) error
+	SetExchangeTransaction(
+		ctx context.Context,
+		walletID uuid.UUID,
+		amount float32,
+		typetransaction contextkey.OperationType,
+		idempotencyKey string,
+		rate *ExchangeRateToCurrency,
+	) error
+	BalanceDetails(ctx context.Context, id uuid.UUID) (map[string]Balance, error)
+	PlaceHold(ctx context.Context, userID uuid.UUID, currency string, amount float32) (uuid.UUID, error)
+	ReleaseHold(ctx context.Context, walletID uuid.UUID, held, captured float32) error
+	CreateHold(ctx context.Context, h *HoldDB) (*HoldDB, error)
+	GetHold(ctx context.Context, id, userID uuid.UUID) (*HoldDB, error)
+	ListHolds(ctx context.Context, userID uuid.UUID, status *HoldStatus) ([]*HoldDB, error)
+	LockHold(ctx context.Context, id, userID uuid.UUID) (*HoldDB, error)
+	LockExpiredHolds(ctx context.Context, now time.Time, limit uint64) ([]*HoldDB, error)
+	CloseHold(ctx context.Context, h *HoldDB) error
+	SaveBalanceSnapshots(ctx context.Context, day time.Time) (int64, error)
+	SaveRateSnapshots(ctx context.Context, day time.Time, rates map[string]float64) error
+	ValuationHistory(ctx context.Context, userID uuid.UUID, currency string, from, to time.Time) ([]ValuationPoint, error)
 }
 
+// ServiceExchanger — источник курсов, ошибки уже приведены к доменным.
+type ServiceExchanger interface {
+	Rates(ctx context.Context) (*rate.Quote, error)
+	Pair(ctx context.Context, from, to string) (float64, time.Time, error)
+}
+type ServiceRateHistory interface {
+	RecordPair(ctx context.Context, from, to string, rate float32, at time.Time) (uuid.UUID, error)
+}
 type ServiceEvents interface {
-	CreateEvent(ctx context.Context, eventType, payload string, tx pgx.Tx) (uuid.UUID, error)
+	CreateEvent(ctx context.Context, eventType, payload string) (uuid.UUID, error)
+}
+type ServiceAudit interface {
+	Record(ctx context.Context, e audit.Entry) error
+}
+type ServiceUsers interface {
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	RequireStepUp(ctx context.Context, userID uuid.UUID, amount float32, currency, code string) error
+	NotificationLocale(ctx context.Context, userID uuid.UUID) (i18n.Locale, error)
 }
 type Service struct {
 	repository ServiceWallets
 	events     ServiceEvents
-	exchanger  walletsv1.ExchangeServiceClient
+	audit      ServiceAudit
+	users      ServiceUsers
+	exchanger  ServiceExchanger
+	history    ServiceRateHistory
 	redisdb    *redis.Client
-	primaryDB  *pgxpool.Pool
-	log        *slog.Logger
+	tx         *db.TxManager
+	holds      HoldOptions
+	// directDeposit разрешает зачисление без платёжного провайдера
+	directDeposit bool
+	log           *slog.Logger
 }
 
-func NewService(r ServiceWallets, events ServiceEvents, primaryDB *pgxpool.Pool, redisdb *redis.Client, exchanger walletsv1.ExchangeServiceClient, log *slog.Logger) *Service {
+func NewService(r ServiceWallets, events ServiceEvents, audit ServiceAudit, users ServiceUsers, tx *db.TxManager, redisdb *redis.Client, exchanger ServiceExchanger, history ServiceRateHistory, holds HoldOptions, directDeposit bool, log *slog.Logger) *Service {
 	return &Service{
-		repository: r,
-		exchanger:  exchanger,
-		redisdb:    redisdb,
-		log:        log,
-		primaryDB:  primaryDB,
-		events:     events,
+		repository:    r,
+		holds:         holds,
+		directDeposit: directDeposit,
+		users:         users,
+		exchanger:     exchanger,
+		history:       history,
+		redisdb:       redisdb,
+		log:           log,
+		tx:            tx,
+		events:        events,
+		audit:         audit,
 	}
 }
 
 func (s *Service) GetCurrencyWallets(ctx context.Context) (*models.CurrencyWallet, error) {
-	const op = "Wallet.Service.GetCurrencyWallets"
+	rates, err := s.ratesSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]float32, len(rates.Rates))
+	for k, v := range rates.Rates {
+		balances[k] = float32(v)
+	}
+	return &models.CurrencyWallet{Balances: balances}, nil
+}
+
+// ratesSnapshot возвращает курсы всех валют из кеша или от обменника вместе со временем их получения.
+func (s *Service) ratesSnapshot(ctx context.Context) (*DefaultRedis, error) {
+	const op = "Wallet.Service.ratesSnapshot"
 	log := s.log.With(slog.String("op", op))
 
 	dataredis, err := s.redisdb.Get(ctx, contextkey.ExchangerCurrencyCtxKey).Bytes()
@@ -73,13 +130,17 @@ func (s *Service) GetCurrencyWallets(ctx context.Context) (*models.CurrencyWalle
 			log.Error("redis error", slog.String("error", err.Error()))
 		}
 
-		exchangerdata, err := s.exchanger.GetExchangeRates(ctx, &emptypb.Empty{})
+		quote, err := s.exchanger.Rates(ctx)
 		if err != nil {
 			log.Error("failed to get exchange rates", slog.String("error", err.Error()))
 			return nil, err
 		}
+		rates := &DefaultRedis{
+			Rates:     quote.Rates,
+			FetchedAt: quote.At,
+		}
 
-		data, err := json.Marshal(exchangerdata.Rates)
+		data, err := json.Marshal(rates)
 		if err != nil {
 			log.Error("failed to marshal rates", slog.String("error", err.Error()))
 			return nil, err
@@ -90,7 +151,7 @@ func (s *Service) GetCurrencyWallets(ctx context.Context) (*models.CurrencyWalle
 			return nil, err
 		}
 
-		return &models.CurrencyWallet{Balances: exchangerdata.Rates}, nil
+		return rates, nil
 	}
 
 	var rates DefaultRedis
@@ -98,21 +159,22 @@ func (s *Service) GetCurrencyWallets(ctx context.Context) (*models.CurrencyWalle
 		log.Error("failed to unmarshal redis data (float64)", slog.String("error", err.Error()))
 		return nil, err
 	}
-
-	balances := make(map[string]float32, len(rates.Rates))
-	for k, v := range rates.Rates {
-		balances[k] = float32(v)
-	}
-
-	log.Info("successfully unmarshaled redis data", slog.Any("rates", balances))
-	return &models.CurrencyWallet{Balances: balances}, nil
+	return &rates, nil
 }
 
 func (s *Service) GetExchangeRateForCurrency(ctx context.Context, to_currency, from_currency string) (*ExchangeRateToCurrency, error) {
 	const op = "Wallet.Service.GetExchangeRateForCurrency"
 
 	log := s.log.With(slog.String("op", op))
-	exchangerrate, err := s.redisdb.Get(ctx, contextkey.ExchangeRateToCurrencyCtxKey).Bytes()
+	if !contextkey.IsKnownCurrency(to_currency) || !contextkey.IsKnownCurrency(from_currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	if to_currency == from_currency {
+		return nil, utils.ErrorSameCurrency
+	}
+	// курс кешируется отдельно для каждой пары валют
+	cacheKey := contextkey.ExchangeRateToCurrencyCtxKey + ":" + from_currency + ":" + to_currency
+	exchangerrate, err := s.redisdb.Get(ctx, cacheKey).Bytes()
 	if err != nil {
 		if err == redis.Nil {
 			log.Info("cache miss (key not found), fetching from exchanger")
@@ -120,29 +182,36 @@ func (s *Service) GetExchangeRateForCurrency(ctx context.Context, to_currency, f
 			log.Error("redis error", slog.String("error", err.Error()))
 		}
 
-		exchangerdata, err := s.exchanger.GetExchangeRateForCurrency(ctx, &walletsv1.CurrencyRequest{ToCurrency: to_currency, FromCurrency: from_currency})
-
+		value, at, err := s.exchanger.Pair(ctx, from_currency, to_currency)
 		if err != nil {
 			log.Error("failed to get exchange rates", slog.String("error", err.Error()))
 			return nil, err
 		}
+		rate := &ExchangeRateToCurrency{
+			Rate:         float32(value),
+			ToCurrency:   to_currency,
+			FromCurrency: from_currency,
+			CapturedAt:   at,
+		}
+		// курс попадает в кеш только после сохранения в историю, чтобы обмен по нему мог сослаться на снимок
+		rate.SnapshotID, err = s.history.RecordPair(ctx, from_currency, to_currency, rate.Rate, rate.CapturedAt)
+		if err != nil {
+			log.Error("failed to record rate snapshot", slog.String("error", err.Error()))
+			return nil, err
+		}
 
-		data, err := json.Marshal(exchangerdata)
+		data, err := json.Marshal(rate)
 		if err != nil {
 			log.Error("failed to marshal rates", slog.String("error", err.Error()))
 			return nil, err
 		}
 
-		if err := s.redisdb.Set(ctx, contextkey.ExchangeRateToCurrencyCtxKey, data, 5*time.Second).Err(); err != nil {
+		if err := s.redisdb.Set(ctx, cacheKey, data, 5*time.Second).Err(); err != nil {
 			log.Error("failed to set data to redis", slog.String("error", err.Error()))
 			return nil, err
 		}
 
-		return &ExchangeRateToCurrency{
-			Rate:         exchangerdata.Rate,
-			ToCurrency:   exchangerdata.ToCurrency,
-			FromCurrency: exchangerdata.FromCurrency,
-		}, nil
+		return rate, nil
 	}
 	var rates ExchangeRateToCurrency
 	if err := json.Unmarshal(exchangerrate, &rates); err != nil {
@@ -153,10 +222,10 @@ func (s *Service) GetExchangeRateForCurrency(ctx context.Context, to_currency, f
 	return &rates, nil
 }
 
-func (s *Service) GetBalance(ctx context.Context, id uuid.UUID) (*models.CurrencyWallet, error) {
+func (s *Service) GetBalance(ctx context.Context, id uuid.UUID) (map[string]Balance, error) {
 	const op = "Wallet.Service.GetBalance"
 	log := s.log.With(slog.String("op", op))
-	data, err := s.repository.Balance(ctx, id)
+	data, err := s.repository.BalanceDetails(ctx, id)
 	if err != nil {
 		log.Error("failed to get balance", slog.String("error", err.Error()))
 		return nil, err
@@ -165,112 +234,306 @@ func (s *Service) GetBalance(ctx context.Context, id uuid.UUID) (*models.Currenc
 	return data, nil
 }
 
-func (s *Service) WalletDepositOrWithDraw(ctx context.Context, id uuid.UUID, currency string, amount float32, typedepo contextkey.OperationType) (*models.CurrencyWallet, error) {
-	const op = "Wallet.Service.GetBalance"
-	log := s.log.With(slog.String("op", op))
-	log.Warn("DepositOrWithdrawBalance props", amount)
-	conn, err := s.primaryDB.Acquire(ctx)
-	if err != nil {
+// AuthorizeWithdraw проверяет второй фактор для крупных списаний до выполнения операции.
+func (s *Service) AuthorizeWithdraw(ctx context.Context, id uuid.UUID, amount float32, currency, totpCode string) error {
+	return s.users.RequireStepUp(ctx, id, amount, currency, totpCode)
+}
 
-		return nil, err
+func (s *Service) WalletDepositOrWithDraw(ctx context.Context, id uuid.UUID, currency string, amount float32, typedepo contextkey.OperationType) (_ *models.CurrencyWallet, err error) {
+	const op = "Wallet.Service.WalletDepositOrWithDraw"
+	log := s.log.With(slog.String("op", op))
+	log.Debug("DepositOrWithdrawBalance props", slog.Any("amount", amount))
+	if !contextkey.IsKnownCurrency(currency) {
+		return nil, utils.ErrorUnknownCurrency
 	}
-	defer conn.Release()
-	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		log.Error("tx error", err.Error())
-		return nil, err
+	if typedepo == contextkey.OperationTypeDeposit && !s.directDeposit {
+		return nil, utils.ErrorDirectDepositDisabled
 	}
-
-	defer func() {
+	if typedepo == contextkey.OperationTypeWithdraw {
+		verified, err := s.users.IsEmailVerified(ctx, id)
+		if err != nil {
+			log.Error("failed to check email verification", slog.String("error", err.Error()))
+			return nil, err
+		}
+		if !verified {
+			return nil, utils.ErrorEmailNotVerified
+		}
+	}
+	var data *models.CurrencyWalletDB
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		data, err = s.repository.DepositOrWithdrawBalance(ctx, id, amount, currency, typedepo)
 		if err != nil {
-			rollbackErr := tx.Rollback(ctx)
-			if rollbackErr != nil {
-				err = errors.Join(err, rollbackErr)
-				log.Error("rollback error", rollbackErr.Error())
-				return
+			log.Error("failed to deposit balance", slog.String("error", err.Error()))
+			return err
+		}
+
+		if err = s.repository.SetTransaction(ctx, data.WalletID, amount, typedepo, nil, ""); err != nil {
+			log.Error("failed to set balance", slog.String("error", err.Error()))
+			return err
+		}
+		if amount >= 1 {
+			code := NotificationDeposit
+			if typedepo == contextkey.OperationTypeWithdraw {
+				code = NotificationWithdraw
+			}
+			var locale i18n.Locale
+			locale, err = s.users.NotificationLocale(ctx, id)
+			if err != nil {
+				log.Error("failed to get notification locale", slog.String("error", err.Error()))
+				return err
+			}
+			var kafkadata []byte
+			kafkadata, err = json.Marshal(KafkaPayloadNotification{
+				Amount:       amount,
+				UserId:       id,
+				BalanceAfter: data.Balances,
+				Currency:     currency,
+				Code:         code,
+				Locale:       string(locale),
+				Message:      i18n.Message(locale, code, amount, currency),
+			})
+			if err != nil {
+				log.Error("failed to marshal balances", slog.String("error", err.Error()))
+				return err
 			}
+			if _, err = s.events.CreateEvent(ctx, string(typedepo), string(kafkadata)); err != nil {
+				return err
+			}
+		}
+		action, before := audit.ActionDeposit, data.Balances[currency]-amount
+		if typedepo == contextkey.OperationTypeWithdraw {
+			action, before = audit.ActionWithdraw, data.Balances[currency]+amount
 		}
+		if err = s.audit.Record(ctx, audit.Entry{
+			Action:   action,
+			ActorID:  &id,
+			TargetID: &id,
+			Before:   map[string]float32{currency: before},
+			After:    map[string]float32{currency: data.Balances[currency]},
+		}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("getting balance for currency")
+	return &models.CurrencyWallet{Balances: data.Balances}, nil
+}
 
-	}()
+// CurrencyExchangeWallet сначала списывает исходную валюту, чтобы нехватка средств
+// вернулась как ErrorInsufficientFunds до зачисления целевой.
+func (s *Service) CurrencyExchangeWallet(ctx context.Context, userid uuid.UUID, rate *ExchangeRateToCurrency, to_currency_amount, from_currency_amount float32) (*models.CurrencyWallet, error) {
+	return s.exchange(ctx, userid, rate, to_currency_amount, from_currency_amount, "")
+}
 
-	data, err := s.repository.DepositOrWithdrawBalance(ctx, id, amount, currency, tx, typedepo)
+// ExchangeAtMarket обменивает amount исходной валюты по текущему курсу.
+// Используется фоновыми задачами, idempotencyKey защищает от повторного обмена.
+func (s *Service) ExchangeAtMarket(ctx context.Context, userid uuid.UUID, from_currency, to_currency string, amount float32, idempotencyKey string) (*models.CurrencyWallet, error) {
+	rate, err := s.GetExchangeRateForCurrency(ctx, to_currency, from_currency)
 	if err != nil {
-		log.Error("failed to deposit balance", slog.String("error", err.Error()))
 		return nil, err
 	}
+	return s.exchange(ctx, userid, rate, amount*rate.Rate, amount, idempotencyKey)
+}
 
-	if err := s.repository.SetTransaction(ctx, data.WalletID, amount, typedepo, nil, tx); err != nil {
-		log.Error("failed to set balance", slog.String("error", err.Error()))
-		return nil, err
+// exchange проводит обмен по курсу rate, обе записи журнала ссылаются на снимок курса.
+func (s *Service) exchange(ctx context.Context, userid uuid.UUID, rate *ExchangeRateToCurrency, to_currency_amount, from_currency_amount float32, idempotencyKey string) (_ *models.CurrencyWallet, err error) {
+	const op = "Wallet.Service.CurrencyExchangeWallet"
+	log := s.log.With(slog.String("op", op))
+	to_currency, from_currency := rate.ToCurrency, rate.FromCurrency
+	if !contextkey.IsKnownCurrency(to_currency) || !contextkey.IsKnownCurrency(from_currency) {
+		return nil, utils.ErrorUnknownCurrency
+	}
+	if to_currency == from_currency {
+		return nil, utils.ErrorSameCurrency
 	}
-	if amount >= 1 {
-		kafkadata, err := json.Marshal(KafkaPayloadNotification{amount, id, data.Balances})
+	var depositdata *models.CurrencyWalletDB
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		withdrawdata, err := s.repository.DepositOrWithdrawBalance(ctx, userid, from_currency_amount, from_currency, contextkey.OperationTypeWithdraw)
 		if err != nil {
-			log.Error("failed to marshal balances", slog.String("error", err.Error()))
-			return nil, err
+			log.Error("failed to withdraw balance", slog.String("error", err.Error()))
+			return err
 		}
-		if _, err := s.events.CreateEvent(ctx, string(typedepo), string(kafkadata), tx); err != nil {
-			return nil, err
+		if err = s.repository.SetExchangeTransaction(ctx, withdrawdata.WalletID, from_currency_amount, contextkey.OperationTypeWithdraw, idempotencyKey, rate); err != nil {
+			log.Error("failed to set transaction", slog.String("error", err.Error()))
+			return err
 		}
-	}
-	if err := tx.Commit(ctx); err != nil {
-		log.Error("failed to commit transaction", slog.String("error", err.Error()))
+		// ключ вешается на списание, зачисление получает производный ключ
+		depositKey := ""
+		if idempotencyKey != "" {
+			depositKey = idempotencyKey + ":deposit"
+		}
+		depositdata, err = s.creditExchange(ctx, userid, rate, to_currency_amount, from_currency_amount, depositKey)
+		if err != nil {
+			return err
+		}
+		if err = s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionExchange,
+			ActorID:  &userid,
+			TargetID: &userid,
+			Before: map[string]float32{
+				from_currency: depositdata.Balances[from_currency] + from_currency_amount,
+				to_currency:   depositdata.Balances[to_currency] - to_currency_amount,
+			},
+			After: map[string]float32{
+				from_currency: depositdata.Balances[from_currency],
+				to_currency:   depositdata.Balances[to_currency],
+			},
+		}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	return &depositdata.CurrencyWallet, nil
+}
 
-	log.Info("getting balance for currency")
-	return &models.CurrencyWallet{Balances: data.Balances}, nil
+// ExchangeHeld обменивает сумму, зарезервированную в held кошелька walletID, например лимитным ордером:
+// снимает резерв и списывает его, зачисляет to_currency_amount по снимку курса rate и уведомляет пользователя
+// так же, как обычный обмен. Выполняется в транзакции из ctx, если она есть.
+func (s *Service) ExchangeHeld(ctx context.Context, userid, walletID uuid.UUID, rate *ExchangeRateToCurrency, to_currency_amount, from_currency_amount float32, idempotencyKey string) (*models.CurrencyWalletDB, error) {
+	var depositdata *models.CurrencyWalletDB
+	err := s.tx.Do(ctx, func(ctx context.Context) (err error) {
+		if err = s.repository.ReleaseHold(ctx, walletID, from_currency_amount, from_currency_amount); err != nil {
+			return err
+		}
+		if err = s.repository.SetExchangeTransaction(ctx, walletID, from_currency_amount, contextkey.OperationTypeWithdraw, idempotencyKey, rate); err != nil {
+			return err
+		}
+		depositdata, err = s.creditExchange(ctx, userid, rate, to_currency_amount, from_currency_amount, idempotencyKey+":deposit")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return depositdata, nil
 }
 
-func (s *Service) CurrencyExchangeWallet(ctx context.Context, userid uuid.UUID, to_currency, from_currency string, to_currency_amount, from_currency_amount float32) (*models.CurrencyWallet, error) {
-	const op = "Wallet.Service.CurrencyExchangeWallet"
+// creditExchange — зачисляющая нога обмена: целевая валюта, запись журнала со снимком курса и уведомление.
+func (s *Service) creditExchange(ctx context.Context, userid uuid.UUID, rate *ExchangeRateToCurrency, to_currency_amount, from_currency_amount float32, idempotencyKey string) (*models.CurrencyWalletDB, error) {
+	const op = "Wallet.Service.creditExchange"
 	log := s.log.With(slog.String("op", op))
-	conn, err := s.primaryDB.Acquire(ctx)
+	to_currency, from_currency := rate.ToCurrency, rate.FromCurrency
+	depositdata, err := s.repository.DepositOrWithdrawBalance(ctx, userid, to_currency_amount, to_currency, contextkey.OperationTypeDeposit)
 	if err != nil {
-
+		log.Error("failed to deposit balance", slog.String("error", err.Error()))
 		return nil, err
 	}
-	defer conn.Release()
-	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err = s.repository.SetExchangeTransaction(ctx, depositdata.WalletID, to_currency_amount, contextkey.OperationTypeDeposit, idempotencyKey, rate); err != nil {
+		log.Error("failed to set transaction", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if to_currency_amount < 2 {
+		return depositdata, nil
+	}
+	locale, err := s.users.NotificationLocale(ctx, userid)
 	if err != nil {
-		log.Error("tx error", err.Error())
+		log.Error("failed to get notification locale", slog.String("error", err.Error()))
 		return nil, err
 	}
-
-	defer func() {
-		if err != nil {
-			rollbackErr := tx.Rollback(ctx)
-			if rollbackErr != nil {
-				err = errors.Join(err, rollbackErr)
-				log.Error("rollback error", rollbackErr.Error())
-				return
-			}
-		}
-
-	}()
-	depositdata, err := s.repository.DepositOrWithdrawBalance(ctx, userid, to_currency_amount, to_currency, tx, contextkey.OperationTypeDeposit)
+	kafkadata, err := json.Marshal(KafkaPayloadNotification{
+		Amount:       to_currency_amount,
+		UserId:       userid,
+		BalanceAfter: depositdata.Balances,
+		Currency:     to_currency,
+		Code:         NotificationExchange,
+		Locale:       string(locale),
+		Message: i18n.Message(locale, NotificationExchange,
+			from_currency_amount, from_currency, to_currency_amount, to_currency),
+	})
 	if err != nil {
-		log.Error("failed to deposit balance", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("не хватает баланса")
+		log.Error("failed to marshal balances", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if _, err = s.events.CreateEvent(ctx, string(contextkey.OperationTypeWithdraw), string(kafkadata)); err != nil {
+		return nil, err
+	}
+	return depositdata, nil
+}
+
+// Transfer переводит amount со счёта currency отправителя на такой же счёт получателя.
+// Обе ноги выполняются в одной транзакции и пишутся в журнал как TRANSFER.
+func (s *Service) Transfer(ctx context.Context, fromUserID, toUserID uuid.UUID, currency string, amount float32, idempotencyKey string) (_ *models.CurrencyWallet, err error) {
+	const op = "Wallet.Service.Transfer"
+	log := s.log.With(slog.String("op", op))
+	if !contextkey.IsKnownCurrency(currency) {
+		return nil, utils.ErrorUnknownCurrency
 	}
-	_, err = s.repository.DepositOrWithdrawBalance(ctx, userid, from_currency_amount, from_currency, tx, contextkey.OperationTypeWithdraw)
+	if fromUserID == toUserID {
+		return nil, utils.ErrorSelfTransfer
+	}
+	verified, err := s.users.IsEmailVerified(ctx, fromUserID)
 	if err != nil {
-		log.Error("failed to withdraw balance", slog.String("error", err.Error()))
+		log.Error("failed to check email verification", slog.String("error", err.Error()))
 		return nil, err
 	}
-	if to_currency_amount >= 2 {
-		kafkadata, err := json.Marshal(KafkaPayloadNotification{to_currency_amount, userid, depositdata.Balances})
+	if !verified {
+		return nil, utils.ErrorEmailNotVerified
+	}
+	var senderdata *models.CurrencyWalletDB
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		senderdata, err = s.repository.DepositOrWithdrawBalance(ctx, fromUserID, amount, currency, contextkey.OperationTypeWithdraw)
+		if err != nil {
+			log.Error("failed to withdraw balance", slog.String("error", err.Error()))
+			return err
+		}
+		recipientdata, err := s.repository.DepositOrWithdrawBalance(ctx, toUserID, amount, currency, contextkey.OperationTypeDeposit)
+		if err != nil {
+			log.Error("failed to deposit balance", slog.String("error", err.Error()))
+			return err
+		}
+		if err = s.repository.SetTransaction(ctx, recipientdata.WalletID, amount, contextkey.OperationTypeTransfer, &senderdata.WalletID, idempotencyKey); err != nil {
+			log.Error("failed to set transaction", slog.String("error", err.Error()))
+			return err
+		}
+
+		var locale i18n.Locale
+		locale, err = s.users.NotificationLocale(ctx, toUserID)
+		if err != nil {
+			log.Error("failed to get notification locale", slog.String("error", err.Error()))
+			return err
+		}
+		var kafkadata []byte
+		kafkadata, err = json.Marshal(KafkaPayloadNotification{
+			Amount:       amount,
+			UserId:       toUserID,
+			BalanceAfter: recipientdata.Balances,
+			Currency:     currency,
+			Code:         NotificationTransfer,
+			Locale:       string(locale),
+			Message:      i18n.Message(locale, NotificationTransfer, amount, currency),
+		})
 		if err != nil {
 			log.Error("failed to marshal balances", slog.String("error", err.Error()))
-			return nil, err
+			return err
 		}
-		if _, err := s.events.CreateEvent(ctx, string(contextkey.OperationTypeWithdraw), string(kafkadata), tx); err != nil {
-			return nil, err
+		if _, err = s.events.CreateEvent(ctx, string(contextkey.OperationTypeTransfer), string(kafkadata)); err != nil {
+			return err
 		}
-	}
-	if err := tx.Commit(ctx); err != nil {
-		log.Error("failed to commit transaction", slog.String("error", err.Error()))
+		if err = s.audit.Record(ctx, audit.Entry{
+			Action:   audit.ActionTransfer,
+			ActorID:  &fromUserID,
+			TargetID: &toUserID,
+			Before: map[string]map[string]float32{
+				"sender":    {currency: senderdata.Balances[currency] + amount},
+				"recipient": {currency: recipientdata.Balances[currency] - amount},
+			},
+			After: map[string]map[string]float32{
+				"sender":    {currency: senderdata.Balances[currency]},
+				"recipient": {currency: recipientdata.Balances[currency]},
+			},
+		}); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return &depositdata.CurrencyWallet, err
+	return &senderdata.CurrencyWallet, nil
 }
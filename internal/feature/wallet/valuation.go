@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	valuationDateLayout = "2006-01-02"
+	// valuationDefaultDays — длина истории, если период не задан
+	valuationDefaultDays = 30
+	valuationMaxDays     = 366
+)
+
+// ValueBalances пересчитывает балансы в валюту currency по текущим курсам обменника.
+// Курсы обменника заданы относительно общей базы (единиц валюты за единицу базы),
+// поэтому кросс-курс X→currency равен rates[currency] / rates[X].
+func (s *Service) ValueBalances(ctx context.Context, balances map[string]Balance, currency string) (*Valuation, error) {
+	rates, err := s.ratesSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, ok := rates.Rates[currency]
+	if !ok || target <= 0 {
+		return nil, utils.ErrorExchangeRateUnavailable
+	}
+	v := &Valuation{
+		Currency: currency,
+		RatesAt:  rates.FetchedAt,
+		Wallets:  make(map[string]WalletValuation, len(balances)),
+	}
+	for cur, b := range balances {
+		rate, ok := rates.Rates[cur]
+		if !ok || rate <= 0 {
+			return nil, utils.ErrorExchangeRateUnavailable
+		}
+		w := WalletValuation{
+			Amount: roundCents(float64(b.Total)),
+			Rate:   target / rate,
+		}
+		w.Value = roundCents(w.Amount * w.Rate)
+		v.Wallets[cur] = w
+		v.Total += w.Value
+	}
+	v.Total = roundCents(v.Total)
+	return v, nil
+}
+
+// ValuationHistory возвращает стоимость кошельков пользователя на конец каждого дня периода.
+// Без дат берутся последние 30 дней, период длиннее года отклоняется.
+func (s *Service) ValuationHistory(ctx context.Context, userID uuid.UUID, req ValuationHistoryRequest) ([]ValuationPoint, error) {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.To != "" {
+		parsed, err := time.Parse(valuationDateLayout, req.To)
+		if err != nil {
+			return nil, utils.ErrorValuationPeriodInvalid
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -(valuationDefaultDays - 1))
+	if req.From != "" {
+		parsed, err := time.Parse(valuationDateLayout, req.From)
+		if err != nil {
+			return nil, utils.ErrorValuationPeriodInvalid
+		}
+		from = parsed
+	}
+	if to.Before(from) || to.Sub(from) >= valuationMaxDays*24*time.Hour {
+		return nil, utils.ErrorValuationPeriodInvalid
+	}
+	return s.repository.ValuationHistory(ctx, userID, req.Valuation, from, to)
+}
+
+// StartBalanceSnapshots по тикеру сохраняет снимок балансов и курсов за текущий день (UTC).
+// Снимок за день перезаписывается, поэтому в истории остаётся последнее значение дня.
+func (s *Service) StartBalanceSnapshots(ctx context.Context, handlePeriod time.Duration) {
+	const op = "Wallet.Service.StartBalanceSnapshots"
+
+	log := s.log.With(slog.String("op", op))
+	ticker := time.NewTicker(handlePeriod)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("stopping balance snapshots")
+				return
+
+			case <-ticker.C:
+				rates, err := s.ratesSnapshot(ctx)
+				if err != nil {
+					log.Error("failed to get rates for snapshot", logger.Err(err))
+					continue
+				}
+				if len(rates.Rates) == 0 {
+					log.Warn("exchanger returned no rates, snapshot skipped")
+					continue
+				}
+				day := time.Now().UTC().Truncate(24 * time.Hour)
+				var count int64
+				err = s.tx.Do(ctx, func(ctx context.Context) error {
+					if err := s.repository.SaveRateSnapshots(ctx, day, rates.Rates); err != nil {
+						return err
+					}
+					count, err = s.repository.SaveBalanceSnapshots(ctx, day)
+					return err
+				})
+				if err != nil {
+					log.Error("failed to save balance snapshots", logger.Err(err))
+					continue
+				}
+				log.Info("saved balance snapshots", slog.String("day", day.Format(valuationDateLayout)), slog.Int64("wallets", count))
+			}
+		}
+	}()
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,61 @@
+package customiddleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+// AuditMeta кладёт в контекст id запроса и адрес клиента для журнала аудита.
+// Должен стоять после middleware.RequestID и middleware.RealIP.
+func AuditMeta(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := audit.WithMeta(r.Context(), audit.Meta{
+			RequestID: middleware.GetReqID(r.Context()),
+			IP:        clientIP(r),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RoleChecker сообщает, есть ли у пользователя роль администратора.
+type RoleChecker interface {
+	IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+// UserChecker — проверки пользователя из токена: активность сессии и роль.
+type UserChecker interface {
+	SessionChecker
+	RoleChecker
+}
+
+// RequireAdmin пропускает только администраторов и должен стоять после AuthMiddleware.
+// Роль читается из базы на каждый запрос, поэтому снятие роли действует сразу, без перевыпуска токенов.
+func RequireAdmin(roles RoleChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := GetJWTClaimsFromCtx(r.Context())
+			if err != nil {
+				api.WriteError(w, r, utils.ErrorUnauthorized)
+				return
+			}
+			admin, err := roles.IsAdmin(r.Context(), claims.ID)
+			if err != nil {
+				slog.Error("failed check role middleware", slog.String("error", err.Error()))
+				api.WriteError(w, r, err)
+				return
+			}
+			if !admin {
+				api.WriteError(w, r, utils.ErrorAdminRequired)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package customiddleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Deadline ограничивает контекст запроса сроком timeout. Срок доходит до исходящих вызовов
+// (база, gRPC-обменник), и они не продолжают работу после того, как ответ клиенту уже не успеть отдать.
+func Deadline(timeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if timeout <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package customiddleware
+
+import (
+	"net/http"
+
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+)
+
+// LocaleMiddleware кладёт в контекст язык ответа из Accept-Language.
+func LocaleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale := i18n.FromAcceptLanguage(r.Header.Get("Accept-Language"))
+		w.Header().Set("Content-Language", string(locale))
+		next.ServeHTTP(w, r.WithContext(i18n.WithLocale(r.Context(), locale)))
+	})
+}
@@ -2,9 +2,9 @@ package customiddleware
 
 import (
 	"context"
-	"errors"
 	"github.com/Sanchir01/currency-wallet/internal/domain/contants"
 	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"log/slog"
 	"net/http"
@@ -39,14 +39,15 @@ var requesDuration = prometheus.NewHistogramVec(
 )
 
 func GetJWTClaimsFromCtx(ctx context.Context) (*user.Claims, error) {
-	claims, ok := ctx.Value(contextkey.UserIDCtxKey).(*user.Claims)
-	if !ok {
-		return nil, errors.New("no JWT claims found in context")
-	}
-	return claims, nil
+	return user.ClaimsFromContext(ctx)
+}
+
+// SessionChecker подтверждает, что сессия из токена не отозвана, и отмечает её активность.
+type SessionChecker interface {
+	TouchSession(ctx context.Context, sessionID, userID uuid.UUID, ip string) (bool, error)
 }
 
-func AuthMiddleware(domain string) func(http.Handler) http.Handler {
+func AuthMiddleware(domain string, sessions SessionChecker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			access, err := r.Cookie("refreshToken")
@@ -63,7 +64,11 @@ func AuthMiddleware(domain string) func(http.Handler) http.Handler {
 				}
 				token, err := user.ParseToken(accessToken)
 				if err != nil {
-					slog.Error("failed parse token middleware", err.Error())
+					slog.Error("failed parse token middleware", slog.String("error", err.Error()))
+					next.ServeHTTP(w, r)
+					return
+				}
+				if token.Purpose != "" || !sessionActive(r, sessions, token) {
 					next.ServeHTTP(w, r)
 					return
 				}
@@ -75,7 +80,11 @@ func AuthMiddleware(domain string) func(http.Handler) http.Handler {
 
 			validAccessToken, err := user.ParseToken(access.Value)
 			if err != nil {
-				slog.Error("failed parse token middleware", err.Error())
+				slog.Error("failed parse token middleware", slog.String("error", err.Error()))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if validAccessToken.Purpose != "" || !sessionActive(r, sessions, validAccessToken) {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -84,6 +93,18 @@ func AuthMiddleware(domain string) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+func sessionActive(r *http.Request, sessions SessionChecker, claims *user.Claims) bool {
+	if claims.SessionID == uuid.Nil {
+		return false
+	}
+	active, err := sessions.TouchSession(r.Context(), claims.SessionID, claims.ID, clientIP(r))
+	if err != nil {
+		slog.Error("failed check session middleware", slog.String("error", err.Error()))
+		return false
+	}
+	return active
+}
 func PrometheusMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
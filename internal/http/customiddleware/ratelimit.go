@@ -0,0 +1,217 @@
+package customiddleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/logger"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// slidingWindowScript атомарно чистит устаревшие записи окна, считает оставшиеся
+// и либо добавляет текущий запрос, либо возвращает через сколько миллисекунд освободится место.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+if count < limit then
+	redis.call('ZADD', key, now, member)
+	redis.call('PEXPIRE', key, window)
+	return {1, 0}
+end
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+return {0, tonumber(oldest[2]) + window - now}
+`)
+
+type RateLimiter struct {
+	rdb     *redis.Client
+	log     *slog.Logger
+	enabled bool
+}
+
+func NewRateLimiter(rdb *redis.Client, enabled bool, log *slog.Logger) *RateLimiter {
+	return &RateLimiter{
+		rdb:     rdb,
+		log:     log,
+		enabled: enabled,
+	}
+}
+
+// Allow регистрирует запрос в скользящем окне ключа и сообщает, укладывается ли он в лимит.
+func (l *RateLimiter) Allow(ctx context.Context, key string, limit config.RouteLimit) (bool, time.Duration, error) {
+	now := time.Now().UnixMilli()
+	res, err := slidingWindowScript.Run(ctx, l.rdb, []string{"ratelimit:" + key},
+		now, limit.Window.Milliseconds(), limit.Requests, fmt.Sprintf("%d-%s", now, uuid.NewString()),
+	).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// ByIP ограничивает маршрут по адресу клиента. RemoteAddr уже заменён middleware.RealIP.
+func (l *RateLimiter) ByIP(name string, limit config.RouteLimit) func(http.Handler) http.Handler {
+	return l.middleware(name, limit, func(r *http.Request) string {
+		return "ip:" + clientIP(r)
+	})
+}
+
+// ByUser ограничивает маршрут по пользователю из JWT и должен стоять после AuthMiddleware.
+// Для запросов без токена используется адрес клиента.
+func (l *RateLimiter) ByUser(name string, limit config.RouteLimit) func(http.Handler) http.Handler {
+	return l.middleware(name, limit, func(r *http.Request) string {
+		claims, err := GetJWTClaimsFromCtx(r.Context())
+		if err != nil {
+			return "ip:" + clientIP(r)
+		}
+		return "user:" + claims.ID.String()
+	})
+}
+
+func (l *RateLimiter) middleware(name string, limit config.RouteLimit, keyFn func(r *http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !l.enabled || limit.Requests <= 0 || limit.Window <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed, retryAfter, err := l.Allow(r.Context(), name+":"+keyFn(r), limit)
+			if err != nil {
+				l.log.Error("rate limiter unavailable", slog.String("route", name), logger.Err(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !allowed {
+				TooManyRequests(w, r, retryAfter)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	api.WriteError(w, r, &utils.RetryAfterError{Err: utils.ErrorTooManyRequests, RetryAfter: retryAfter})
+}
+
+// LoginLockout прогрессивно блокирует аккаунт после неудачных попыток входа:
+// после MaxFailures ошибок на BaseLockout, далее каждая новая ошибка удваивает блокировку до MaxLockout.
+type LoginLockout struct {
+	rdb     *redis.Client
+	cfg     config.LoginLockout
+	enabled bool
+}
+
+func NewLoginLockout(rdb *redis.Client, enabled bool, cfg config.LoginLockout) *LoginLockout {
+	return &LoginLockout{
+		rdb:     rdb,
+		cfg:     cfg,
+		enabled: enabled,
+	}
+}
+
+func (l *LoginLockout) LockedFor(ctx context.Context, account string) (time.Duration, error) {
+	if !l.enabled {
+		return 0, nil
+	}
+	ttl, err := l.rdb.PTTL(ctx, lockKey(account)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (l *LoginLockout) RegisterFailure(ctx context.Context, account string) (time.Duration, error) {
+	if !l.enabled || l.cfg.MaxFailures <= 0 {
+		return 0, nil
+	}
+	key := failuresKey(account)
+	pipe := l.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, l.cfg.FailuresWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	failures := incr.Val()
+	if failures < int64(l.cfg.MaxFailures) {
+		return 0, nil
+	}
+	lock := l.cfg.BaseLockout << min(failures-int64(l.cfg.MaxFailures), 30)
+	if lock <= 0 || lock > l.cfg.MaxLockout {
+		lock = l.cfg.MaxLockout
+	}
+	if err := l.rdb.Set(ctx, lockKey(account), failures, lock).Err(); err != nil {
+		return 0, err
+	}
+	return lock, nil
+}
+
+func (l *LoginLockout) Reset(ctx context.Context, account string) error {
+	if !l.enabled {
+		return nil
+	}
+	if err := l.rdb.Del(ctx, failuresKey(account), lockKey(account)).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	return nil
+}
+
+// RegisterChallengeFailure считает неверные коды, введённые по одному challenge токену 2FA, и возвращает
+// их число. Счётчик живёт до истечения токена ttl. В отличие от блокировки аккаунта он работает и при
+// выключенном rate limit: по счётчику гасится challenge, а не ограничивается частота запросов.
+func (l *LoginLockout) RegisterChallengeFailure(ctx context.Context, challengeID string, ttl time.Duration) (int64, error) {
+	key := challengeKey(challengeID)
+	pipe := l.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, max(ttl, time.Second))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// ChallengeFailures возвращает число неверных кодов по challenge токену.
+func (l *LoginLockout) ChallengeFailures(ctx context.Context, challengeID string) (int64, error) {
+	n, err := l.rdb.Get(ctx, challengeKey(challengeID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return n, err
+}
+
+func failuresKey(account string) string {
+	return "login:failures:" + strings.ToLower(account)
+}
+
+func lockKey(account string) string {
+	return "login:lock:" + strings.ToLower(account)
+}
+
+func challengeKey(challengeID string) string {
+	return "login:challenge:" + challengeID
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
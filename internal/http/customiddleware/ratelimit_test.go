@@ -0,0 +1,160 @@
+package customiddleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return mr, rdb
+}
+
+// limitedHandler — маршрут за ByIP с лимитом limit.
+func limitedHandler(rdb *redis.Client, limit config.RouteLimit) http.Handler {
+	l := NewRateLimiter(rdb, true, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	return l.ByIP("test", limit)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func get(h http.Handler, ip string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = ip + ":1234"
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+// TestRateLimiterSlidingWindow — сверх лимита запросы отклоняются, у каждого адреса своё окно,
+// а после окна лимит снова доступен.
+func TestRateLimiterSlidingWindow(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	h := limitedHandler(rdb, config.RouteLimit{Requests: 2, Window: 200 * time.Millisecond})
+
+	for i := 0; i < 2; i++ {
+		if w := get(h, "10.0.0.1"); w.Code != http.StatusOK {
+			t.Fatalf("request %d: status %d", i+1, w.Code)
+		}
+	}
+	if w := get(h, "10.0.0.1"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over limit: status %d, want 429", w.Code)
+	}
+	if w := get(h, "10.0.0.2"); w.Code != http.StatusOK {
+		t.Errorf("other address: status %d", w.Code)
+	}
+
+	time.Sleep(250 * time.Millisecond)
+	if w := get(h, "10.0.0.1"); w.Code != http.StatusOK {
+		t.Errorf("after window: status %d", w.Code)
+	}
+}
+
+// TestRateLimiterRetryAfter — Retry-After показывает, через сколько секунд освободится место в окне.
+func TestRateLimiterRetryAfter(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	limit := config.RouteLimit{Requests: 1, Window: 10 * time.Second}
+	h := limitedHandler(rdb, limit)
+
+	get(h, "10.0.0.1")
+	w := get(h, "10.0.0.1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("status %d, want 429", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "10" {
+		t.Errorf("Retry-After = %q, want 10", got)
+	}
+
+	l := NewRateLimiter(rdb, true, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	allowed, retryAfter, err := l.Allow(context.Background(), "test:ip:10.0.0.1", limit)
+	if err != nil || allowed {
+		t.Fatalf("allow: %v, %v", allowed, err)
+	}
+	if retryAfter <= 9*time.Second || retryAfter > limit.Window {
+		t.Errorf("retry after = %v, want about %v", retryAfter, limit.Window)
+	}
+}
+
+// TestRateLimiterFailOpen — при недоступном Redis запросы пропускаются.
+func TestRateLimiterFailOpen(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	h := limitedHandler(rdb, config.RouteLimit{Requests: 1, Window: time.Minute})
+	mr.SetError("LOADING redis is loading the dataset in memory")
+
+	for i := 0; i < 3; i++ {
+		if w := get(h, "10.0.0.1"); w.Code != http.StatusOK {
+			t.Fatalf("request %d: status %d, want 200", i+1, w.Code)
+		}
+	}
+}
+
+// TestLoginLockoutDoubles — блокировка начинается с MaxFailures-й ошибки, удваивается с каждой следующей
+// до MaxLockout и снимается Reset.
+func TestLoginLockoutDoubles(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	ctx := context.Background()
+	l := NewLoginLockout(rdb, true, config.LoginLockout{
+		MaxFailures:    3,
+		BaseLockout:    time.Minute,
+		MaxLockout:     5 * time.Minute,
+		FailuresWindow: time.Hour,
+	})
+
+	want := []time.Duration{0, 0, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, w := range want {
+		lock, err := l.RegisterFailure(ctx, "Alice@Example.com")
+		if err != nil {
+			t.Fatalf("failure %d: %v", i+1, err)
+		}
+		if lock != w {
+			t.Errorf("failure %d: lock = %v, want %v", i+1, lock, w)
+		}
+	}
+	locked, err := l.LockedFor(ctx, "alice@example.com")
+	if err != nil || locked <= 4*time.Minute || locked > 5*time.Minute {
+		t.Errorf("locked for %v, %v; want about 5m", locked, err)
+	}
+
+	if err := l.Reset(ctx, "alice@example.com"); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+	if locked, err := l.LockedFor(ctx, "alice@example.com"); err != nil || locked != 0 {
+		t.Errorf("locked after reset: %v, %v", locked, err)
+	}
+	if lock, _ := l.RegisterFailure(ctx, "alice@example.com"); lock != 0 {
+		t.Errorf("failure after reset: lock = %v, want 0", lock)
+	}
+}
+
+// TestChallengeFailures — неверные коды считаются по каждому challenge отдельно, счётчик истекает вместе с токеном.
+func TestChallengeFailures(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	ctx := context.Background()
+	l := NewLoginLockout(rdb, false, config.LoginLockout{})
+
+	for i := int64(1); i <= 3; i++ {
+		n, err := l.RegisterChallengeFailure(ctx, "first", time.Minute)
+		if err != nil || n != i {
+			t.Fatalf("failure %d: %v, %v", i, n, err)
+		}
+	}
+	if n, err := l.ChallengeFailures(ctx, "second"); err != nil || n != 0 {
+		t.Errorf("other challenge failures = %v, %v; want 0", n, err)
+	}
+	mr.FastForward(time.Minute)
+	if n, err := l.ChallengeFailures(ctx, "first"); err != nil || n != 0 {
+		t.Errorf("failures after expiry = %v, %v; want 0", n, err)
+	}
+}
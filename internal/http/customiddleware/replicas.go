@@ -0,0 +1,61 @@
+package customiddleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/google/uuid"
+)
+
+// ReadRouter помнит, какие пользователи только что меняли данные, см. db.Router.
+type ReadRouter interface {
+	MarkWrite(ctx context.Context, userID uuid.UUID)
+	RecentWrite(ctx context.Context, userID uuid.UUID) bool
+}
+
+// PrimaryForWrites направляет в основную базу все чтения запросов, которые меняют данные:
+// вход, регистрация и вебхуки читают то, что могли только что записать сами или соседние запросы.
+func PrimaryForWrites(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !safeMethod(r.Method) {
+			r = r.WithContext(db.WithPrimary(r.Context()))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ReadYourWrites должен стоять после AuthMiddleware. После запроса, меняющего данные, пользователь
+// на время db.ReplicaOptions.StickyWindow читает из основной базы и видит свои изменения,
+// даже если следующий запрос попадёт на другой экземпляр сервиса.
+func ReadYourWrites(reads ReadRouter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := GetJWTClaimsFromCtx(r.Context())
+			if reads == nil || err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if safeMethod(r.Method) {
+				if reads.RecentWrite(r.Context(), claims.ID) {
+					r = r.WithContext(db.WithPrimary(r.Context()))
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+			// отметка ставится до ответа, чтобы следующий запрос клиента её уже видел, и продлевается после:
+			// окно отсчитывается от коммита, а не от начала долгого запроса. Повторная отметка ставится и
+			// после отменённого по дедлайну запроса, изменения могли успеть закоммититься
+			reads.MarkWrite(r.Context(), claims.ID)
+			next.ServeHTTP(w, r)
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), time.Second)
+			defer cancel()
+			reads.MarkWrite(ctx, claims.ID)
+		})
+	}
+}
+
+func safeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -3,6 +3,7 @@ package httphandlers
 import (
 	_ "github.com/Sanchir01/currency-wallet/docs"
 	"github.com/Sanchir01/currency-wallet/internal/app"
+	"github.com/Sanchir01/currency-wallet/internal/config"
 	"github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
 	"github.com/Sanchir01/currency-wallet/pkg/logger"
 	"github.com/go-chi/chi/v5"
@@ -11,21 +12,85 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 	"log/slog"
 	"net/http"
+	"time"
 )
 
-func StartHTTTPHandlers(handlers *app.Handlers, domain string, l *slog.Logger) http.Handler {
+func StartHTTTPHandlers(
+	handlers *app.Handlers,
+	domain string,
+	users customiddleware.UserChecker,
+	reads customiddleware.ReadRouter,
+	limiter *customiddleware.RateLimiter,
+	limits config.RateLimit,
+	maxBodyBytes int64,
+	requestTimeout time.Duration,
+	l *slog.Logger,
+) http.Handler {
 	router := chi.NewRouter()
-	custommiddleware(router, l)
+	custommiddleware(router, maxBodyBytes, l)
 	router.Route("/api/v1", func(r chi.Router) {
-		r.Post("/register", handlers.UserHandler.RegisterHandler)
-		r.Post("/login", handlers.UserHandler.LoginHandler)
+		r.With(limiter.ByIP("register", limits.Routes["register"])).
+			Post("/register", handlers.UserHandler.RegisterHandler)
+		r.With(limiter.ByIP("login", limits.Routes["login"])).
+			Post("/login", handlers.UserHandler.LoginHandler)
 		r.Group(func(r chi.Router) {
-			r.Use(customiddleware.AuthMiddleware(domain))
-			r.Get("/exchange/rates", handlers.WalletHandler.GetAllCurrencyHandler)
-			r.Get("/balance", handlers.WalletHandler.GetBalanceHandler)
-			r.Post("/deposit", handlers.WalletHandler.DepositWallet)
-			r.Post("/withdraw", handlers.WalletHandler.WithdrawWallet)
-			r.Post("/exchange", handlers.WalletHandler.ExchangeWallet)
+			r.Use(limiter.ByIP("auth", limits.Routes["auth"]))
+			r.Post("/verify-email/confirm", handlers.UserHandler.ConfirmEmailHandler)
+			r.Post("/password-reset/request", handlers.UserHandler.RequestPasswordResetHandler)
+			r.Post("/password-reset/confirm", handlers.UserHandler.ConfirmPasswordResetHandler)
+			r.Post("/2fa/login", handlers.UserHandler.TwoFactorLoginHandler)
+		})
+		r.With(limiter.ByIP("webhook", limits.Routes["webhook"])).
+			Post("/payments/webhooks/{provider}", handlers.PaymentHandler.WebhookHandler)
+		r.Group(func(r chi.Router) {
+			r.Use(customiddleware.AuthMiddleware(domain, users))
+			r.Use(customiddleware.ReadYourWrites(reads))
+			r.Use(limiter.ByUser("api", limits.Routes["api"]))
+			// выписка отдаётся потоком и сама продлевает срок записи, общий дедлайн запроса к ней не применяется
+			r.Get("/statements", handlers.StatementHandler.StatementHandler)
+			r.Group(func(r chi.Router) {
+				r.Use(customiddleware.Deadline(requestTimeout))
+				r.Post("/verify-email/request", handlers.UserHandler.RequestEmailVerificationHandler)
+				r.Post("/2fa/enroll", handlers.UserHandler.EnrollTwoFactorHandler)
+				r.Post("/2fa/enable", handlers.UserHandler.EnableTwoFactorHandler)
+				r.Post("/2fa/disable", handlers.UserHandler.DisableTwoFactorHandler)
+				r.Get("/me", handlers.UserHandler.GetProfileHandler)
+				r.Patch("/me", handlers.UserHandler.UpdateProfileHandler)
+				r.Delete("/me", handlers.UserHandler.CloseAccountHandler)
+				r.Post("/me/password", handlers.UserHandler.ChangePasswordHandler)
+				r.Get("/sessions", handlers.UserHandler.ListSessionsHandler)
+				r.Delete("/sessions/{id}", handlers.UserHandler.RevokeSessionHandler)
+				r.Get("/exchange/rates", handlers.WalletHandler.GetAllCurrencyHandler)
+				r.Get("/exchange/rates/at", handlers.RateHandler.RateAtHandler)
+				r.Get("/exchange/rates/candles", handlers.RateHandler.CandlesHandler)
+				r.Get("/balance", handlers.WalletHandler.GetBalanceHandler)
+				r.Get("/balance/history", handlers.WalletHandler.BalanceHistoryHandler)
+				r.Get("/exchange/orders", handlers.OrderHandler.ListOrdersHandler)
+				r.Delete("/exchange/orders/{id}", handlers.OrderHandler.CancelOrderHandler)
+				r.Get("/schedules", handlers.ScheduleHandler.ListSchedulesHandler)
+				r.Get("/schedules/{id}", handlers.ScheduleHandler.GetScheduleHandler)
+				r.Delete("/schedules/{id}", handlers.ScheduleHandler.CancelScheduleHandler)
+				r.Get("/holds", handlers.WalletHandler.ListHoldsHandler)
+				r.Get("/holds/{id}", handlers.WalletHandler.GetHoldHandler)
+				r.Get("/payments", handlers.PaymentHandler.ListPaymentsHandler)
+				r.Get("/payments/{id}", handlers.PaymentHandler.GetPaymentHandler)
+				r.With(customiddleware.RequireAdmin(users)).
+					Get("/admin/audit", handlers.AuditHandler.ListHandler)
+				r.Group(func(r chi.Router) {
+					r.Use(limiter.ByUser("money", limits.Routes["money"]))
+					r.Post("/deposit", handlers.WalletHandler.DepositWallet)
+					r.Post("/withdraw", handlers.WalletHandler.WithdrawWallet)
+					r.Post("/exchange", handlers.WalletHandler.ExchangeWallet)
+					r.Post("/exchange/orders", handlers.OrderHandler.PlaceOrderHandler)
+					r.Post("/schedules", handlers.ScheduleHandler.CreateScheduleHandler)
+					r.Patch("/schedules/{id}", handlers.ScheduleHandler.UpdateScheduleHandler)
+					r.Post("/holds", handlers.WalletHandler.AuthorizeHoldHandler)
+					r.Post("/holds/{id}/capture", handlers.WalletHandler.CaptureHoldHandler)
+					r.Post("/holds/{id}/void", handlers.WalletHandler.VoidHoldHandler)
+					r.Post("/payments/topups", handlers.PaymentHandler.CreateTopUpHandler)
+					r.Post("/payments/payouts", handlers.PaymentHandler.CreatePayoutHandler)
+				})
+			})
 		})
 	})
 	router.Get("/swagger/*", httpSwagger.Handler(
@@ -33,11 +98,15 @@ func StartHTTTPHandlers(handlers *app.Handlers, domain string, l *slog.Logger) h
 	))
 	return router
 }
-func custommiddleware(router *chi.Mux, l *slog.Logger) {
+func custommiddleware(router *chi.Mux, maxBodyBytes int64, l *slog.Logger) {
 	router.Use(middleware.RequestID, middleware.Recoverer)
 	router.Use(middleware.RealIP)
+	router.Use(customiddleware.AuditMeta)
+	router.Use(customiddleware.PrimaryForWrites)
 	router.Use(logger.NewMiddlewareLogger(l))
 	router.Use(customiddleware.PrometheusMiddleware)
+	router.Use(customiddleware.LocaleMiddleware)
+	router.Use(customiddleware.BodyLimit(maxBodyBytes))
 }
 func StartPrometheusHandlers() http.Handler {
 	router := chi.NewRouter()
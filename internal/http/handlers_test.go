@@ -0,0 +1,96 @@
+package httphandlers
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Sanchir01/currency-wallet/internal/app"
+	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	specPath = "../../api/openapi.json"
+	apiBase  = "/api/v1"
+)
+
+// TestRoutesMatchOpenAPISpec проверяет, что каждый маршрут API описан в api/openapi.json
+// и каждая операция спецификации действительно обслуживается роутером.
+func TestRoutesMatchOpenAPISpec(t *testing.T) {
+	spec := loadSpecOperations(t)
+	routes := routerOperations(t)
+
+	for op := range routes {
+		if !spec[op] {
+			t.Errorf("route %s is served but missing from the OpenAPI spec", op)
+		}
+	}
+	for op := range spec {
+		if !routes[op] {
+			t.Errorf("operation %s is in the OpenAPI spec but not served by the router", op)
+		}
+	}
+}
+
+func loadSpecOperations(t *testing.T) map[string]bool {
+	t.Helper()
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		t.Fatalf("read spec: %v", err)
+	}
+	var doc struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("parse spec: %v", err)
+	}
+	ops := make(map[string]bool)
+	for path, item := range doc.Paths {
+		for method := range item {
+			switch strings.ToUpper(method) {
+			case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+				ops[strings.ToUpper(method)+" "+path] = true
+			}
+		}
+	}
+	if len(ops) == 0 {
+		t.Fatal("spec has no operations")
+	}
+	return ops
+}
+
+func routerOperations(t *testing.T) map[string]bool {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handlers := &app.Handlers{
+		UserHandler:   user.NewHandler(nil, log),
+		WalletHandler: wallet.NewHandler(nil, log),
+	}
+	router := StartHTTTPHandlers(handlers, "localhost", nil, nil,
+		customiddleware.NewRateLimiter(nil, false, log), config.RateLimit{}, 0, 0, log)
+
+	ops := make(map[string]bool)
+	err := chi.Walk(router.(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		path, ok := strings.CutPrefix(route, apiBase)
+		if !ok {
+			return nil
+		}
+		ops[method+" "+path] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk routes: %v", err)
+	}
+	if len(ops) == 0 {
+		t.Fatal("router has no API routes")
+	}
+	return ops
+}
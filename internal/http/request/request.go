@@ -0,0 +1,121 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultMaxBodyBytes ограничивает тело, если лимит не выставил middleware.
+const DefaultMaxBodyBytes int64 = 1 << 20
+
+// Decode читает JSON-тело в dst и валидирует его. Неизвестные поля и лишние данные
+// после объекта отклоняются. Ошибки уже доменные и готовы для api.WriteError.
+func Decode(w http.ResponseWriter, r *http.Request, dst any) error {
+	body := http.MaxBytesReader(w, r.Body, DefaultMaxBodyBytes)
+	dec := json.NewDecoder(body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dst); err != nil {
+		return decodeError(err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return utils.ErrorInvalidRequestBody
+	}
+	return Validate(dst)
+}
+
+// Validate проверяет структуру общим валидатором и собирает ошибки по полям.
+func Validate(v any) error {
+	err := Validator().Struct(v)
+	if err == nil {
+		return nil
+	}
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err
+	}
+	fields := make([]api.FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		param := fe.Param()
+		switch {
+		case strings.HasSuffix(fe.Tag(), "field"):
+			param = jsonName(v, param)
+		case strings.HasPrefix(fe.Tag(), "required_if"), strings.HasPrefix(fe.Tag(), "excluded_unless"):
+			// required_if=Kind TRANSFER превращается в kind=TRANSFER
+			field, value, _ := strings.Cut(param, " ")
+			param = jsonName(v, field) + "=" + value
+		}
+		fields = append(fields, api.FieldError{
+			Field: fieldPath(fe.Namespace()),
+			Rule:  fe.Tag(),
+			Param: param,
+		})
+	}
+	return &api.ValidationError{Fields: fields}
+}
+
+func decodeError(err error) error {
+	var maxErr *http.MaxBytesError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &maxErr):
+		return utils.ErrorRequestTooLarge
+	case errors.As(err, &typeErr):
+		return &api.ValidationError{Fields: []api.FieldError{{Field: typeErr.Field, Rule: "type", Param: jsonType(typeErr.Type)}}}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return &api.ValidationError{Fields: []api.FieldError{{Field: field, Rule: "unknown"}}}
+	}
+	return utils.ErrorInvalidRequestBody
+}
+
+// fieldPath убирает имя корневой структуры: "LoginRequest.email" -> "email".
+func fieldPath(namespace string) string {
+	if _, rest, ok := strings.Cut(namespace, "."); ok {
+		return rest
+	}
+	return namespace
+}
+
+// jsonName переводит имя поля структуры из параметра правила (nefield=FromCurrency) в имя из JSON.
+func jsonName(v any, field string) string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return field
+	}
+	f, ok := t.FieldByName(field)
+	if !ok {
+		return field
+	}
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return field
+}
+
+// jsonType называет ожидаемый тип так, как его видит клиент API.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return "object"
+}
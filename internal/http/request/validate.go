@@ -0,0 +1,90 @@
+package request
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	contextkey "github.com/Sanchir01/currency-wallet/internal/domain/contants"
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	once     sync.Once
+	validate *validator.Validate
+
+	isoCurrency = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Validator возвращает общий валидатор: он кеширует разобранные структуры,
+// поэтому создавать его на каждый запрос дорого.
+func Validator() *validator.Validate {
+	once.Do(func() {
+		validate = validator.New(validator.WithRequiredStructEnabled())
+		// в ошибках поле называется так же, как в JSON
+		validate.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return f.Name
+			}
+			return name
+		})
+		mustRegister("positive", positive)
+		mustRegister("money", money)
+		mustRegister("currency", currency)
+	})
+	return validate
+}
+
+func mustRegister(tag string, fn validator.Func) {
+	if err := validate.RegisterValidation(tag, fn); err != nil {
+		panic(err)
+	}
+}
+
+// positive — число строго больше нуля.
+func positive(fl validator.FieldLevel) bool {
+	f := fl.Field()
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.Int() > 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return f.Uint() > 0
+	case reflect.Float32, reflect.Float64:
+		return f.Float() > 0
+	}
+	return false
+}
+
+// money — сумма не меньше 0.01 и не больше двух знаков после запятой: колонки сумм DECIMAL с двумя
+// знаками, и 0.001 округлилась бы в базе до нуля. Знаки считаются по кратчайшей записи числа
+// в его разрядности, поэтому 0.1 во float32 не превращается в 0.100000001.
+func money(fl validator.FieldLevel) bool {
+	f := fl.Field()
+	bits := 64
+	switch f.Kind() {
+	case reflect.Float32:
+		bits = 32
+	case reflect.Float64:
+	default:
+		return false
+	}
+	v := f.Float()
+	if v <= 0 {
+		return false
+	}
+	s := strconv.FormatFloat(v, 'f', -1, bits)
+	_, frac, _ := strings.Cut(s, ".")
+	return len(frac) <= 2
+}
+
+// currency — код валюты ISO 4217, который поддерживает кошелёк.
+func currency(fl validator.FieldLevel) bool {
+	code := fl.Field().String()
+	return isoCurrency.MatchString(code) && contextkey.IsKnownCurrency(code)
+}
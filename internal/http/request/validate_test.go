@@ -0,0 +1,28 @@
+package request
+
+import "testing"
+
+func TestMoney(t *testing.T) {
+	type req struct {
+		Amount float32 `validate:"required,money"`
+	}
+	tests := []struct {
+		amount float32
+		valid  bool
+	}{
+		{0.01, true},
+		{0.1, true},
+		{12345.67, true},
+		{100, true},
+		{0.001, false},
+		{1.005, false},
+		{0, false},
+		{-5, false},
+	}
+	for _, tt := range tests {
+		err := Validator().Struct(req{Amount: tt.amount})
+		if (err == nil) != tt.valid {
+			t.Errorf("amount %v: valid = %v, want %v (%v)", tt.amount, err == nil, tt.valid, err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package integration
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Sanchir01/currency-wallet/internal/feature/admin"
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/events"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func newAdminService(t *testing.T, h *harness) (*admin.Service, *user.KeyStore) {
+	t.Helper()
+	box, err := secretbox.New(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("secretbox: %v", err)
+	}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	keys := user.NewKeyStore(user.NewRepository(h.pool, nil), box)
+	if err := keys.Load(context.Background()); err != nil {
+		t.Fatalf("load keys: %v", err)
+	}
+	tx := db.NewTxManager(h.pool, log)
+	return admin.NewService(admin.NewRepository(h.pool), wallet.NewRepository(h.pool, nil, log), events.NewRepository(h.pool),
+		audit.NewService(audit.NewRepository(h.pool), tx, log), keys, tx, log), keys
+}
+
+func (h *harness) userID(email string) uuid.UUID {
+	h.t.Helper()
+	var id uuid.UUID
+	if err := h.pool.QueryRow(context.Background(), "SELECT id FROM users WHERE email = $1", email).Scan(&id); err != nil {
+		h.t.Fatalf("user id %s: %v", email, err)
+	}
+	return id
+}
+
+// TestAdminAdjustAndReconcile — корректировка попадает в журнал и не ломает сверку,
+// а изменение баланса в обход журнала сверка находит.
+func TestAdminAdjustAndReconcile(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+	alice := h.register("alice@example.com", "alice")
+	if status := alice.do(http.MethodPost, "/deposit", wallet.DepositOrWithdrawRequest{Amount: 100, Currency: "USD"}, nil); status != http.StatusOK {
+		t.Fatalf("deposit: status %d", status)
+	}
+	svc, _ := newAdminService(t, h)
+	aliceID := h.userID("alice@example.com")
+
+	adjustment, err := svc.Adjust(ctx, admin.AdjustRequest{UserID: aliceID, Currency: "USD", Amount: -30, Reason: "duplicate deposit", Operator: "ops"})
+	if err != nil {
+		t.Fatalf("adjust: %v", err)
+	}
+	if adjustment.BalanceBefore != 100 || adjustment.BalanceAfter != 70 {
+		t.Errorf("adjustment balances = %v -> %v, want 100 -> 70", adjustment.BalanceBefore, adjustment.BalanceAfter)
+	}
+	if _, err := svc.Adjust(ctx, admin.AdjustRequest{UserID: aliceID, Currency: "USD", Amount: -500, Reason: "too much", Operator: "ops"}); utils.AsError(err) != utils.ErrorInsufficientFunds {
+		t.Errorf("overdraft adjustment: err = %v, want insufficient funds", err)
+	}
+	if _, err := svc.Adjust(ctx, admin.AdjustRequest{UserID: aliceID, Currency: "USD", Amount: 5, Operator: "ops"}); utils.AsError(err) != utils.ErrorAdjustmentInvalid {
+		t.Errorf("adjustment without reason: err = %v, want adjustment_invalid", err)
+	}
+
+	list, err := svc.Transactions(ctx, aliceID, "USD", 10)
+	if err != nil {
+		t.Fatalf("transactions: %v", err)
+	}
+	if len(list) != 2 || list[0].Amount != -3000 || list[0].Reference == nil || !strings.HasPrefix(*list[0].Reference, "adjustment:") {
+		t.Errorf("latest transaction is not the adjustment: %+v", list)
+	}
+
+	if mismatches, err := svc.Reconcile(ctx, uuid.Nil); err != nil || len(mismatches) != 0 {
+		t.Fatalf("reconcile after adjustment: %v, %+v", err, mismatches)
+	}
+	if _, err := h.pool.Exec(ctx, "UPDATE wallets SET balance = balance + 5 WHERE user_id = $1 AND currency = 'USD'", aliceID); err != nil {
+		t.Fatalf("tamper balance: %v", err)
+	}
+	mismatches, err := svc.Reconcile(ctx, aliceID)
+	if err != nil {
+		t.Fatalf("reconcile: %v", err)
+	}
+	if len(mismatches) != 1 || mismatches[0].Currency != "USD" || mismatches[0].Difference() != 500 {
+		t.Errorf("mismatches = %+v, want USD off by 5.00", mismatches)
+	}
+}
+
+// TestRotateJWTKey — после ротации новые токены подписываются ключом из БД,
+// а выданные раньше по JWT_SECRET продолжают работать.
+func TestRotateJWTKey(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+	alice := h.register("alice@example.com", "alice")
+	svc, keys := newAdminService(t, h)
+	user.UseKeyStore(keys)
+	t.Cleanup(func() { user.UseKeyStore(nil) })
+
+	if _, err := keys.Rotate(ctx, 0); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	list, err := svc.JWTKeys(ctx)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("keys = %+v, %v, want one key", list, err)
+	}
+
+	if status := alice.do(http.MethodGet, "/balance", nil, nil); status != http.StatusOK {
+		t.Errorf("token issued before rotation: status %d", status)
+	}
+	bob := h.register("bob@example.com", "bob")
+	token, _, err := jwt.NewParser().ParseUnverified(bob.cookies["accessToken"].Value, &user.Claims{})
+	if err != nil {
+		t.Fatalf("parse token after rotation: %v", err)
+	}
+	if kid := token.Header["kid"]; kid != list[0].Kid {
+		t.Errorf("token after rotation has kid %v, want %s", kid, list[0].Kid)
+	}
+	if status := bob.do(http.MethodGet, "/balance", nil, nil); status != http.StatusOK {
+		t.Errorf("token issued after rotation: status %d", status)
+	}
+}
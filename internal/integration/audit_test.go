@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/Sanchir01/currency-wallet/internal/feature/audit"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+)
+
+// TestAuditLog — действия попадают в журнал, читать его через API может только администратор,
+// а правку записи в обход триггеров находит проверка цепочки.
+func TestAuditLog(t *testing.T) {
+	h := newHarness(t)
+	ctx := context.Background()
+	alice := h.register("alice@example.com", "alice")
+	if status := alice.do(http.MethodPost, "/deposit", wallet.DepositOrWithdrawRequest{Amount: 100, Currency: "USD"}, nil); status != http.StatusOK {
+		t.Fatalf("deposit: status %d", status)
+	}
+	aliceID := h.userID("alice@example.com")
+
+	if status := alice.do(http.MethodGet, "/admin/audit", nil, nil); status != http.StatusForbidden {
+		t.Errorf("audit as user: status %d, want 403", status)
+	}
+	svc, _ := newAdminService(t, h)
+	if err := svc.SetRole(ctx, aliceID, user.RoleAdmin, "ops"); err != nil {
+		t.Fatalf("set role: %v", err)
+	}
+
+	// в цепочку записи встраивает писатель, в тесте его заменяет Flush
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	if _, err := audit.NewService(audit.NewRepository(h.pool), db.NewTxManager(h.pool, log), log).Flush(ctx); err != nil {
+		t.Fatalf("flush audit: %v", err)
+	}
+
+	var list audit.ListResponse
+	if status := alice.do(http.MethodGet, "/admin/audit?target_id="+aliceID.String(), nil, &list); status != http.StatusOK {
+		t.Fatalf("audit as admin: status %d", status)
+	}
+	want := []audit.Action{audit.ActionRoleChange, audit.ActionDeposit, audit.ActionRegister}
+	if len(list.Records) != len(want) {
+		t.Fatalf("records = %+v, want %v", list.Records, want)
+	}
+	for i, action := range want {
+		if list.Records[i].Action != action {
+			t.Errorf("record %d action = %s, want %s", i, list.Records[i].Action, action)
+		}
+	}
+	if list.Records[0].Actor != "ops" {
+		t.Errorf("role change actor = %q, want ops", list.Records[0].Actor)
+	}
+
+	if _, err := h.pool.Exec(ctx, "DELETE FROM audit_log"); err == nil {
+		t.Error("delete from audit_log succeeded")
+	}
+	result, err := svc.VerifyAudit(ctx)
+	if err != nil || !result.OK() {
+		t.Fatalf("verify: %+v, %v", result, err)
+	}
+
+	if _, err := h.pool.Exec(ctx, "ALTER TABLE audit_log DISABLE TRIGGER audit_log_no_update"); err != nil {
+		t.Fatalf("disable trigger: %v", err)
+	}
+	if _, err := h.pool.Exec(ctx, "UPDATE audit_log SET actor = 'mallory' WHERE id = $1", list.Records[1].ID); err != nil {
+		t.Fatalf("tamper record: %v", err)
+	}
+	result, err = svc.VerifyAudit(ctx)
+	if err != nil {
+		t.Fatalf("verify after tamper: %v", err)
+	}
+	if result.BrokenAt != list.Records[1].ID {
+		t.Errorf("broken at %d, want %d", result.BrokenAt, list.Records[1].ID)
+	}
+}
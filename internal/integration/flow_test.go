@@ -0,0 +1,129 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	"github.com/Sanchir01/currency-wallet/pkg/api"
+)
+
+// TestWalletFlow — регистрация, пополнение, обмен и вывод через HTTP API с проверкой журнала операций.
+func TestWalletFlow(t *testing.T) {
+	h := newHarness(t)
+	alice := h.register("alice@example.com", "alice")
+
+	var deposit wallet.DepositOrWithdrawResponse
+	if status := alice.do(http.MethodPost, "/deposit", wallet.DepositOrWithdrawRequest{Amount: 100, Currency: "USD"}, &deposit); status != http.StatusOK {
+		t.Fatalf("deposit: status %d, code %q", status, deposit.Code)
+	}
+	if got := deposit.Balances["USD"]; got != 100 {
+		t.Errorf("USD after deposit = %v, want 100", got)
+	}
+
+	var exchange wallet.ExchangeResponse
+	if status := alice.do(http.MethodPost, "/exchange", wallet.ExchangeRequest{FromCurrency: "USD", ToCurrency: "RUB", Amount: 10}, &exchange); status != http.StatusOK {
+		t.Fatalf("exchange: status %d, code %q", status, exchange.Code)
+	}
+	if exchange.ExchangedAmount != 900 {
+		t.Errorf("exchanged amount = %v, want 900", exchange.ExchangedAmount)
+	}
+
+	var withdraw wallet.DepositOrWithdrawResponse
+	if status := alice.do(http.MethodPost, "/withdraw", wallet.DepositOrWithdrawRequest{Amount: 500, Currency: "RUB"}, &withdraw); status != http.StatusOK {
+		t.Fatalf("withdraw: status %d, code %q", status, withdraw.Code)
+	}
+	if got := withdraw.Balances["RUB"]; got != 400 {
+		t.Errorf("RUB after withdraw = %v, want 400", got)
+	}
+
+	var overdraft api.Response
+	if status := alice.do(http.MethodPost, "/withdraw", wallet.DepositOrWithdrawRequest{Amount: 1000, Currency: "RUB"}, &overdraft); status != http.StatusUnprocessableEntity || overdraft.Code != "insufficient_funds" {
+		t.Errorf("overdraft: status %d, code %q, want 422 insufficient_funds", status, overdraft.Code)
+	}
+
+	var balance wallet.BalanceResponse
+	if status := alice.do(http.MethodGet, "/balance", nil, &balance); status != http.StatusOK {
+		t.Fatalf("balance: status %d", status)
+	}
+	for currency, want := range map[string]float32{"USD": 90, "RUB": 400, "EUR": 0} {
+		if got := balance.Balances[currency].Total; got != want {
+			t.Errorf("balance %s = %v, want %v", currency, got, want)
+		}
+	}
+
+	// пополнение, две ноги обмена и вывод; обе ноги обмена ссылаются на снимок курса
+	var total, exchangeLegs int
+	if err := h.pool.QueryRow(context.Background(), `
+		SELECT count(*), count(t.rate_snapshot_id)
+		FROM transactions t JOIN wallets w ON w.id = t.wallet_id
+		JOIN users u ON u.id = w.user_id
+		WHERE u.email = $1`, "alice@example.com").Scan(&total, &exchangeLegs); err != nil {
+		t.Fatalf("count transactions: %v", err)
+	}
+	if total != 4 || exchangeLegs != 2 {
+		t.Errorf("transactions = %d (with rate snapshot %d), want 4 (2)", total, exchangeLegs)
+	}
+}
+
+// TestConcurrentWithdrawals — параллельные списания с одного кошелька не уводят баланс в минус
+// и проходят ровно столько раз, сколько позволяет остаток.
+func TestConcurrentWithdrawals(t *testing.T) {
+	h := newHarness(t)
+	bob := h.register("bob@example.com", "bob")
+	if status := bob.do(http.MethodPost, "/deposit", wallet.DepositOrWithdrawRequest{Amount: 100, Currency: "EUR"}, nil); status != http.StatusOK {
+		t.Fatalf("deposit: status %d", status)
+	}
+
+	const workers = 10
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		statuses = make(map[int]int)
+		errs     []error
+	)
+	start := make(chan struct{})
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			var resp api.Response
+			status, err := bob.request(http.MethodPost, "/withdraw", wallet.DepositOrWithdrawRequest{Amount: 30, Currency: "EUR"}, &resp)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			statuses[status]++
+		}()
+	}
+	close(start)
+	wg.Wait()
+	for _, err := range errs {
+		t.Error(err)
+	}
+	if statuses[http.StatusOK] != 3 || statuses[http.StatusUnprocessableEntity] != workers-3 {
+		t.Errorf("statuses = %v, want 3 x 200 and %d x 422", statuses, workers-3)
+	}
+
+	var balance wallet.BalanceResponse
+	if status := bob.do(http.MethodGet, "/balance", nil, &balance); status != http.StatusOK {
+		t.Fatalf("balance: status %d", status)
+	}
+	if got := balance.Balances["EUR"].Total; got != 10 {
+		t.Errorf("EUR after withdrawals = %v, want 10", got)
+	}
+	var withdrawals int
+	if err := h.pool.QueryRow(context.Background(), `
+		SELECT count(*) FROM transactions t JOIN wallets w ON w.id = t.wallet_id
+		WHERE w.currency = 'EUR' AND t.type = 'WITHDRAW'`).Scan(&withdrawals); err != nil {
+		t.Fatalf("count withdrawals: %v", err)
+	}
+	if withdrawals != 3 {
+		t.Errorf("withdraw transactions = %d, want 3", withdrawals)
+	}
+}
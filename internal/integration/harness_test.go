@@ -0,0 +1,271 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/internal/app"
+	"github.com/Sanchir01/currency-wallet/internal/config"
+	"github.com/Sanchir01/currency-wallet/internal/feature/payment"
+	"github.com/Sanchir01/currency-wallet/internal/feature/user"
+	"github.com/Sanchir01/currency-wallet/internal/feature/wallet"
+	httphandlers "github.com/Sanchir01/currency-wallet/internal/http"
+	"github.com/Sanchir01/currency-wallet/internal/http/customiddleware"
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/Sanchir01/currency-wallet/pkg/fakeexchanger"
+	"github.com/Sanchir01/currency-wallet/pkg/mailer"
+	"github.com/Sanchir01/currency-wallet/pkg/secretbox"
+	grpcapp "github.com/Sanchir01/currency-wallet/pkg/server/grpc"
+	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// databaseURLEnv — DSN Postgres, на котором тесты создают временную базу. Пользователь должен иметь право CREATE DATABASE.
+const databaseURLEnv = "INTEGRATION_DATABASE_URL"
+
+// harness — приложение целиком: роутер StartHTTTPHandlers поверх отдельной базы Postgres,
+// Redis в памяти и поддельного обменника.
+type harness struct {
+	t         *testing.T
+	server    *httptest.Server
+	pool      *pgxpool.Pool
+	exchanger *fakeexchanger.Server
+	mail      *captureMailer
+}
+
+func newHarness(t *testing.T) *harness {
+	t.Helper()
+	adminURL := databaseURL(t)
+	t.Setenv("JWT_SECRET", "integration-secret")
+	t.Setenv("DOMAIN_PROD", "")
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	pool := createDatabase(t, adminURL)
+	migrator, err := db.NewMigrator(pool, log)
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	defer migrator.Close()
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("apply migrations: %v", err)
+	}
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	exchanger := fakeexchanger.New(map[string]float32{"USD": 1, "EUR": 0.9, "RUB": 90})
+	dialOpts, stop := fakeexchanger.Bufconn(exchanger)
+	t.Cleanup(stop)
+	client, err := grpcapp.NewClientGRPC(log, fakeexchanger.BufconnAddr, grpcapp.ClientOptions{
+		Timeout:        2 * time.Second,
+		AttemptTimeout: time.Second,
+		DialOptions:    dialOpts,
+	}, walletsv1.NewExchangeServiceClient)
+	if err != nil {
+		t.Fatalf("exchanger client: %v", err)
+	}
+
+	cfg := &config.Config{Rates: config.Rates{Base: "USD"}}
+	rates, err := app.NewRateProvider(cfg, client, log)
+	if err != nil {
+		t.Fatalf("rate provider: %v", err)
+	}
+	box, err := secretbox.New(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("secretbox: %v", err)
+	}
+	database := &db.Database{PrimaryDB: pool, RedisDB: rdb}
+	mail := &captureMailer{}
+	repos := app.NewRepository(database, log)
+	services := app.NewServices(repos, database, log, rates, nil, mail, "http://wallet.test",
+		user.TwoFactorOptions{Box: box, Issuer: "currency-wallet"},
+		customiddleware.NewLoginLockout(rdb, false, config.LoginLockout{}),
+		time.Hour,
+		wallet.HoldOptions{DefaultTTL: time.Hour, MaxTTL: 24 * time.Hour},
+		payment.NewFakeProvider(payment.FakeOptions{Secret: []byte("integration-secret")}, log),
+		true,
+	)
+	router := httphandlers.StartHTTTPHandlers(app.NewHandlers(services, log), "localhost", services.UserService, database.ReadRouter(),
+		customiddleware.NewRateLimiter(rdb, false, log), config.RateLimit{}, 1<<20, 5*time.Second, log)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return &harness{t: t, server: server, pool: pool, exchanger: exchanger, mail: mail}
+}
+
+// databaseURL возвращает DSN из INTEGRATION_DATABASE_URL, без него тест пропускается.
+func databaseURL(t *testing.T) string {
+	t.Helper()
+	adminURL := os.Getenv(databaseURLEnv)
+	if adminURL == "" {
+		t.Skipf("%s is not set", databaseURLEnv)
+	}
+	if testing.Short() {
+		t.Skip("integration test in short mode")
+	}
+	return adminURL
+}
+
+// createDatabase создаёт пустую базу с уникальным именем и удаляет её после теста.
+func createDatabase(t *testing.T, adminURL string) *pgxpool.Pool {
+	t.Helper()
+	ctx := context.Background()
+	admin, err := pgx.Connect(ctx, adminURL)
+	if err != nil {
+		t.Fatalf("connect to %s: %v", databaseURLEnv, err)
+	}
+	suffix := make([]byte, 6)
+	_, _ = rand.Read(suffix)
+	name := "wallet_it_" + hex.EncodeToString(suffix)
+	if _, err := admin.Exec(ctx, "CREATE DATABASE "+pgx.Identifier{name}.Sanitize()); err != nil {
+		_ = admin.Close(ctx)
+		t.Fatalf("create database: %v", err)
+	}
+
+	cfg, err := pgxpool.ParseConfig(adminURL)
+	if err != nil {
+		t.Fatalf("parse %s: %v", databaseURLEnv, err)
+	}
+	cfg.ConnConfig.Database = name
+	cfg.MaxConns = 20
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("connect to %s: %v", name, err)
+	}
+	t.Cleanup(func() {
+		pool.Close()
+		if _, err := admin.Exec(ctx, "DROP DATABASE IF EXISTS "+pgx.Identifier{name}.Sanitize()+" WITH (FORCE)"); err != nil {
+			t.Logf("drop database %s: %v", name, err)
+		}
+		_ = admin.Close(ctx)
+	})
+	return pool
+}
+
+// captureMailer сохраняет письма, чтобы тест мог достать из них ссылку подтверждения.
+type captureMailer struct {
+	mu       sync.Mutex
+	messages []mailer.Message
+}
+
+func (m *captureMailer) Send(_ context.Context, msg mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+var tokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+// lastToken возвращает токен из последнего письма на адрес to.
+func (m *captureMailer) lastToken(t *testing.T, to string) string {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].To != to {
+			continue
+		}
+		if match := tokenPattern.FindStringSubmatch(m.messages[i].Body); match != nil {
+			return match[1]
+		}
+	}
+	t.Fatalf("no message with a token for %s", to)
+	return ""
+}
+
+// apiClient — пользователь API. Cookie хранятся вручную: сервис ставит их с Secure, а тестовый сервер работает по http.
+type apiClient struct {
+	h       *harness
+	mu      sync.Mutex
+	cookies map[string]*http.Cookie
+}
+
+func (h *harness) client() *apiClient {
+	return &apiClient{h: h, cookies: make(map[string]*http.Cookie)}
+}
+
+// do выполняет запрос к /api/v1 и декодирует ответ в out, если он задан. Возвращает HTTP-статус.
+func (c *apiClient) do(method, path string, body, out any) int {
+	c.h.t.Helper()
+	status, err := c.request(method, path, body, out)
+	if err != nil {
+		c.h.t.Fatal(err)
+	}
+	return status
+}
+
+// request — вариант do без t.Fatal, его можно вызывать из горутин.
+func (c *apiClient) request(method, path string, body, out any) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("marshal %s %s: %w", method, path, err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.h.server.URL+"/api/v1"+path, reader)
+	if err != nil {
+		return 0, fmt.Errorf("new request %s %s: %w", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.mu.Lock()
+	for _, cookie := range c.cookies {
+		req.AddCookie(&http.Cookie{Name: cookie.Name, Value: cookie.Value})
+	}
+	c.mu.Unlock()
+
+	resp, err := c.h.server.Client().Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	c.mu.Lock()
+	for _, cookie := range resp.Cookies() {
+		c.cookies[cookie.Name] = cookie
+	}
+	c.mu.Unlock()
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, fmt.Errorf("decode %s %s: %w", method, path, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// register регистрирует пользователя и подтверждает email по ссылке из письма.
+func (h *harness) register(email, username string) *apiClient {
+	h.t.Helper()
+	c := h.client()
+	if status := c.do(http.MethodPost, "/register", user.AuthRequest{
+		Email: email, Username: username, Password: "integration-password",
+	}, nil); status != http.StatusCreated {
+		h.t.Fatalf("register %s: status %d", email, status)
+	}
+	if status := c.do(http.MethodPost, "/verify-email/confirm", user.TokenRequest{
+		Token: h.mail.lastToken(h.t, email),
+	}, nil); status != http.StatusOK {
+		h.t.Fatalf("confirm email %s: status %d", email, status)
+	}
+	return c
+}
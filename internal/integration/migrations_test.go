@@ -0,0 +1,89 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/Sanchir01/currency-wallet/pkg/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TestMigrationsUpDownUp — каждая миграция откатывается без остатка, и повторный накат даёт ту же схему.
+func TestMigrationsUpDownUp(t *testing.T) {
+	pool := createDatabase(t, databaseURL(t))
+	ctx := context.Background()
+	migrator, err := db.NewMigrator(pool, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("migrator: %v", err)
+	}
+	defer migrator.Close()
+
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	first := schema(t, pool)
+	if len(first) == 0 {
+		t.Fatal("schema is empty after up")
+	}
+
+	if err := migrator.DownTo(ctx, 0); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	if left := schema(t, pool); len(left) != 0 {
+		t.Errorf("objects left after down: %v", left)
+	}
+
+	if err := migrator.Up(ctx); err != nil {
+		t.Fatalf("up again: %v", err)
+	}
+	if second := schema(t, pool); !slices.Equal(first, second) {
+		t.Errorf("schema after up again differs:\nfirst:  %v\nsecond: %v", first, second)
+	}
+}
+
+// schema описывает схему public строками: столбцы таблиц, индексы, ограничения, типы и функции.
+// Таблица версий goose и функции расширений в описание не входят.
+func schema(t *testing.T, pool *pgxpool.Pool) []string {
+	t.Helper()
+	rows, err := pool.Query(context.Background(), `
+		SELECT 'column ' || table_name || '.' || column_name || ' ' || data_type || ' ' || is_nullable
+		FROM information_schema.columns
+		WHERE table_schema = 'public' AND table_name <> 'goose_db_version'
+		UNION ALL
+		SELECT 'index ' || indexname || ' ' || indexdef
+		FROM pg_indexes
+		WHERE schemaname = 'public' AND tablename <> 'goose_db_version'
+		UNION ALL
+		SELECT 'constraint ' || conrelid::regclass || '.' || conname || ' ' || pg_get_constraintdef(oid)
+		FROM pg_constraint
+		WHERE connamespace = 'public'::regnamespace AND conrelid <> 'goose_db_version'::regclass
+		UNION ALL
+		SELECT 'type ' || typname
+		FROM pg_type
+		WHERE typnamespace = 'public'::regnamespace AND typtype = 'e'
+		UNION ALL
+		SELECT 'function ' || proname
+		FROM pg_proc p
+		WHERE pronamespace = 'public'::regnamespace
+		  AND NOT EXISTS (SELECT 1 FROM pg_depend d WHERE d.objid = p.oid AND d.deptype = 'e')
+		ORDER BY 1`)
+	if err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	defer rows.Close()
+	var objects []string
+	for rows.Next() {
+		var object string
+		if err := rows.Scan(&object); err != nil {
+			t.Fatalf("scan schema: %v", err)
+		}
+		objects = append(objects, object)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	return objects
+}
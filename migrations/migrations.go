@@ -0,0 +1,9 @@
+// Package migrations встраивает SQL-миграции в бинарник, их применяет db.Migrator.
+package migrations
+
+import "embed"
+
+// Postgres — goose-миграции из каталога pg.
+//
+//go:embed pg/*.sql
+var Postgres embed.FS
@@ -23,5 +23,7 @@ $$ LANGUAGE plpgsql;
 
 -- +goose Down
 -- +goose StatementBegin
-SELECT 'down SQL query';
+-- расширение uuid-ossp не удаляется: IF NOT EXISTS в Up переживает повторный накат, а другие схемы могут им пользоваться
+DROP TABLE IF EXISTS users;
+DROP FUNCTION IF EXISTS update_updated_at_column();
 -- +goose StatementEnd
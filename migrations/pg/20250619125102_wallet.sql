@@ -42,5 +42,7 @@ CREATE INDEX idx_transactions_created_at ON transactions (created_at);
 
 -- +goose Down
 -- +goose StatementBegin
-SELECT 'down SQL query';
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS wallets;
+DROP TYPE IF EXISTS operation_type;
 -- +goose StatementEnd
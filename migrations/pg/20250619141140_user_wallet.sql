@@ -5,5 +5,5 @@ ALTER TABLE wallets ADD COLUMN IF NOT EXISTS user_id UUID NOT NULL REFERENCES us
 
 -- +goose Down
 -- +goose StatementBegin
-SELECT 'down SQL query';
+ALTER TABLE wallets DROP COLUMN IF EXISTS user_id;
 -- +goose StatementEnd
@@ -5,5 +5,5 @@ ALTER TABLE transactions ADD COLUMN IF NOT EXISTS idempotency_key TEXT UNIQUE;
 
 -- +goose Down
 -- +goose StatementBegin
-SELECT 'down SQL query';
+ALTER TABLE transactions DROP COLUMN IF EXISTS idempotency_key;
 -- +goose StatementEnd
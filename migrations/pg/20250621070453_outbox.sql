@@ -13,5 +13,5 @@ CREATE TABLE IF NOT EXISTS  events(
 
 -- +goose Down
 -- +goose StatementBegin
-SELECT 'down SQL query';
+DROP TABLE IF EXISTS events;
 -- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP;
+
+CREATE TYPE user_token_purpose AS ENUM ('EMAIL_VERIFICATION', 'PASSWORD_RESET');
+
+CREATE TABLE IF NOT EXISTS user_tokens(
+                                          id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                          user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                          purpose user_token_purpose NOT NULL,
+                                          token_hash bytea NOT NULL UNIQUE,
+                                          expires_at TIMESTAMP NOT NULL,
+                                          used_at TIMESTAMP,
+                                          created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_tokens_user_id_purpose ON user_tokens (user_id, purpose);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_tokens;
+DROP TYPE IF EXISTS user_token_purpose;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_totp(
+                                        user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+                                        secret_encrypted bytea NOT NULL,
+                                        last_used_step BIGINT NOT NULL DEFAULT 0,
+                                        enabled_at TIMESTAMP,
+                                        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes(
+                                                  id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                                  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                                  code_hash bytea NOT NULL UNIQUE,
+                                                  used_at TIMESTAMP,
+                                                  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_recovery_codes_user_id ON user_recovery_codes (user_id);
+
+CREATE TRIGGER update_user_totp_updated_at
+    BEFORE UPDATE ON user_totp
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
+-- +goose StatementEnd
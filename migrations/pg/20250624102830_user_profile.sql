@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_username_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_active_key ON users (lower(email)) WHERE closed_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS users_username_active_key ON users (lower(username)) WHERE closed_at IS NULL;
+
+CREATE TRIGGER update_users_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+DROP INDEX IF EXISTS users_username_active_key;
+DROP INDEX IF EXISTS users_email_active_key;
+ALTER TABLE users ADD CONSTRAINT users_username_email_key UNIQUE (username, email);
+ALTER TABLE users DROP COLUMN IF EXISTS closed_at;
+-- +goose StatementEnd
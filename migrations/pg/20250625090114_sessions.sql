@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sessions(
+                                       id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                       user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                       device TEXT NOT NULL,
+                                       ip TEXT NOT NULL,
+                                       user_agent TEXT NOT NULL,
+                                       created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                       last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                       expires_at TIMESTAMP NOT NULL,
+                                       revoked_at TIMESTAMP
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sessions;
+-- +goose StatementEnd
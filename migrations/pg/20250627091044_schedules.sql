@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE schedule_kind AS ENUM ('TRANSFER', 'EXCHANGE');
+CREATE TYPE schedule_frequency AS ENUM ('ONCE', 'DAILY', 'WEEKLY', 'MONTHLY');
+CREATE TYPE schedule_status AS ENUM ('ACTIVE', 'PAUSED', 'COMPLETED', 'CANCELLED');
+CREATE TYPE schedule_run_result AS ENUM ('EXECUTED', 'SKIPPED', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS schedules(
+                                        id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                        user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                        kind schedule_kind NOT NULL,
+                                        amount DECIMAL(15, 2) NOT NULL CHECK (amount > 0),
+                                        currency TEXT NOT NULL,
+                                        to_currency TEXT,
+                                        recipient_id UUID REFERENCES users(id) ON DELETE CASCADE,
+                                        frequency schedule_frequency NOT NULL,
+                                        start_at TIMESTAMP NOT NULL,
+                                        end_at TIMESTAMP,
+                                        next_run_at TIMESTAMP,
+                                        occurrence INT NOT NULL DEFAULT 0,
+                                        status schedule_status NOT NULL DEFAULT 'ACTIVE',
+                                        last_run_at TIMESTAMP,
+                                        last_result schedule_run_result,
+                                        locked_until TIMESTAMP,
+                                        version BIGINT NOT NULL DEFAULT 1,
+                                        created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                        CONSTRAINT check_schedule_target CHECK (
+                                            (kind = 'TRANSFER' AND recipient_id IS NOT NULL AND recipient_id <> user_id AND to_currency IS NULL) OR
+                                            (kind = 'EXCHANGE' AND to_currency IS NOT NULL AND to_currency <> currency AND recipient_id IS NULL)
+                                        ),
+                                        CONSTRAINT check_schedule_period CHECK (end_at IS NULL OR end_at > start_at)
+);
+
+CREATE INDEX idx_schedules_user_id ON schedules (user_id);
+CREATE INDEX idx_schedules_due ON schedules (next_run_at) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS schedule_runs(
+                                            schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+                                            occurrence INT NOT NULL,
+                                            scheduled_at TIMESTAMP NOT NULL,
+                                            result schedule_run_result NOT NULL,
+                                            error_code TEXT,
+                                            executed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                            PRIMARY KEY (schedule_id, occurrence)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS schedule_runs;
+DROP TABLE IF EXISTS schedules;
+DROP TYPE IF EXISTS schedule_run_result;
+DROP TYPE IF EXISTS schedule_status;
+DROP TYPE IF EXISTS schedule_frequency;
+DROP TYPE IF EXISTS schedule_kind;
+-- +goose StatementEnd
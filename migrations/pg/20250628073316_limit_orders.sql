@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE limit_order_status AS ENUM ('OPEN', 'EXECUTED', 'CANCELLED', 'EXPIRED');
+
+CREATE TABLE IF NOT EXISTS limit_orders(
+                                           id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                           user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                           from_currency TEXT NOT NULL,
+                                           to_currency TEXT NOT NULL,
+                                           amount DECIMAL(15, 2) NOT NULL CHECK (amount > 0),
+                                           target_rate DECIMAL(18, 8) NOT NULL CHECK (target_rate > 0),
+                                           status limit_order_status NOT NULL DEFAULT 'OPEN',
+                                           expires_at TIMESTAMP NOT NULL,
+                                           executed_rate DECIMAL(18, 8),
+                                           executed_amount DECIMAL(15, 2),
+                                           closed_at TIMESTAMP,
+                                           created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                           updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                           CONSTRAINT check_limit_order_pair CHECK (from_currency <> to_currency),
+                                           CONSTRAINT check_limit_order_execution CHECK (
+                                               (status = 'EXECUTED') = (executed_rate IS NOT NULL AND executed_amount IS NOT NULL)
+                                           )
+);
+
+CREATE INDEX idx_limit_orders_user_id ON limit_orders (user_id, created_at DESC);
+CREATE INDEX idx_limit_orders_open ON limit_orders (from_currency, to_currency, target_rate) WHERE status = 'OPEN';
+CREATE INDEX idx_limit_orders_expiry ON limit_orders (expires_at) WHERE status = 'OPEN';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS limit_orders;
+DROP TYPE IF EXISTS limit_order_status;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS held DECIMAL(10, 2) NOT NULL DEFAULT 0;
+ALTER TABLE wallets ADD CONSTRAINT check_wallet_held CHECK (held >= 0 AND held <= balance);
+
+CREATE TYPE hold_status AS ENUM ('AUTHORIZED', 'CAPTURED', 'VOIDED', 'EXPIRED');
+
+CREATE TABLE IF NOT EXISTS holds(
+                                    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
+                                    currency TEXT NOT NULL,
+                                    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+                                    captured_amount DECIMAL(10, 2),
+                                    status hold_status NOT NULL DEFAULT 'AUTHORIZED',
+                                    reference TEXT,
+                                    expires_at TIMESTAMP NOT NULL,
+                                    closed_at TIMESTAMP,
+                                    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    CONSTRAINT check_hold_capture CHECK (
+                                        (status = 'CAPTURED') = (captured_amount IS NOT NULL)
+                                            AND (captured_amount IS NULL OR (captured_amount > 0 AND captured_amount <= amount))
+                                    )
+);
+
+CREATE INDEX idx_holds_user_id ON holds (user_id, created_at DESC);
+CREATE INDEX idx_holds_expiry ON holds (expires_at) WHERE status = 'AUTHORIZED';
+-- reference — ключ идемпотентности интеграции, повторная авторизация с тем же reference отклоняется
+CREATE UNIQUE INDEX idx_holds_user_reference ON holds (user_id, reference) WHERE reference IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS holds;
+DROP TYPE IF EXISTS hold_status;
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS check_wallet_held;
+ALTER TABLE wallets DROP COLUMN IF EXISTS held;
+-- +goose StatementEnd
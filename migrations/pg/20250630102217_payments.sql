@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE payment_kind AS ENUM ('TOPUP', 'PAYOUT');
+CREATE TYPE payment_status AS ENUM ('PENDING', 'COMPLETED', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS payments(
+                                       id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                       user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                       kind payment_kind NOT NULL,
+                                       provider TEXT NOT NULL,
+                                       provider_reference TEXT,
+                                       currency TEXT NOT NULL,
+                                       amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+                                       status payment_status NOT NULL DEFAULT 'PENDING',
+                                       destination TEXT,
+                                       redirect_url TEXT,
+                                       failure_reason TEXT,
+                                       completed_at TIMESTAMP,
+                                       created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                       updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                       CONSTRAINT check_payout_destination CHECK (kind <> 'PAYOUT' OR destination IS NOT NULL)
+);
+
+CREATE INDEX idx_payments_user_id ON payments (user_id, created_at DESC);
+-- идентификатор операции у провайдера уникален в пределах провайдера
+CREATE UNIQUE INDEX idx_payments_provider_reference ON payments (provider, provider_reference) WHERE provider_reference IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payments;
+DROP TYPE IF EXISTS payment_status;
+DROP TYPE IF EXISTS payment_kind;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- balance_snapshots — остаток кошелька на конец дня (UTC), последний снимок за день перезаписывает предыдущий
+CREATE TABLE IF NOT EXISTS balance_snapshots(
+                                    day DATE NOT NULL,
+                                    wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
+                                    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    currency TEXT NOT NULL,
+                                    balance DECIMAL(10, 2) NOT NULL,
+                                    taken_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    PRIMARY KEY (day, wallet_id)
+);
+
+CREATE INDEX idx_balance_snapshots_user_day ON balance_snapshots (user_id, day);
+
+-- rate_snapshots — курсы обменника на тот же момент, чтобы пересчитывать историю в любую валюту
+CREATE TABLE IF NOT EXISTS rate_snapshots(
+                                    day DATE NOT NULL,
+                                    currency TEXT NOT NULL,
+                                    rate DOUBLE PRECISION NOT NULL CHECK (rate > 0),
+                                    taken_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    PRIMARY KEY (day, currency)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_snapshots;
+DROP TABLE IF EXISTS balance_snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rate_history — курсы пар валют, полученные от обменника. Строки одного запроса к обменнику
+-- имеют общий snapshot_id. Таблица секционирована по месяцам, секции создаёт сервис курсов заранее.
+CREATE TABLE IF NOT EXISTS rate_history(
+                                    snapshot_id UUID NOT NULL,
+                                    from_currency TEXT NOT NULL,
+                                    to_currency TEXT NOT NULL,
+                                    rate DOUBLE PRECISION NOT NULL CHECK (rate > 0),
+                                    captured_at TIMESTAMP NOT NULL,
+                                    PRIMARY KEY (snapshot_id, from_currency, to_currency, captured_at)
+) PARTITION BY RANGE (captured_at);
+
+CREATE INDEX idx_rate_history_pair ON rate_history (from_currency, to_currency, captured_at DESC);
+
+-- секции на текущий и следующий месяц, дальше их создаёт сервис
+DO $$
+DECLARE
+    month DATE := date_trunc('month', CURRENT_TIMESTAMP)::DATE;
+BEGIN
+    FOR i IN 0..1 LOOP
+        EXECUTE format(
+            'CREATE TABLE IF NOT EXISTS %I PARTITION OF rate_history FOR VALUES FROM (%L) TO (%L)',
+            'rate_history_' || to_char(month + make_interval(months => i), 'YYYYMM'),
+            month + make_interval(months => i),
+            month + make_interval(months => i + 1)
+        );
+    END LOOP;
+END $$;
+
+-- курс, по которому прошёл обмен. Внешнего ключа нет: старые секции истории можно отсоединять,
+-- ссылка остаётся для сверки, пока секция доступна
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS rate_snapshot_id UUID;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS rate_captured_at TIMESTAMP;
+ALTER TABLE transactions ADD CONSTRAINT check_transaction_rate_snapshot CHECK (
+    (rate_snapshot_id IS NULL) = (rate_captured_at IS NULL)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS check_transaction_rate_snapshot;
+ALTER TABLE transactions DROP COLUMN IF EXISTS rate_captured_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS rate_snapshot_id;
+DROP TABLE IF EXISTS rate_history;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- user_id объявлен NOT NULL, поэтому ON DELETE SET NULL не мог сработать и удаление пользователя падало
+-- с ошибкой NOT NULL. Аккаунты закрываются через closed_at, а удаление пользователя с кошельками запрещается явно,
+-- чтобы вместе с ним не пропал журнал операций.
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_user_id_fkey;
+ALTER TABLE wallets ADD CONSTRAINT wallets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_wallets_user_id ON wallets (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_wallets_user_id;
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_user_id_fkey;
+ALTER TABLE wallets ADD CONSTRAINT wallets_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ключи подписи JWT. Новый ключ начинает подписывать токены с activates_at, к этому моменту его успевают
+-- подхватить все экземпляры сервиса. Прежний ключ проверяет уже выданные токены ещё срок жизни refresh-токена.
+CREATE TABLE IF NOT EXISTS jwt_keys(
+                                       kid TEXT PRIMARY KEY,
+                                       secret_encrypted BYTEA NOT NULL,
+                                       activates_at TIMESTAMP NOT NULL,
+                                       retired_at TIMESTAMP,
+                                       created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ручные корректировки балансов оператором. Операция в журнале transactions ссылается
+-- на корректировку ключом идемпотентности adjustment:<id>.
+CREATE TABLE IF NOT EXISTS wallet_adjustments(
+                                                 id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+                                                 wallet_id UUID NOT NULL REFERENCES wallets(id) ON DELETE RESTRICT,
+                                                 user_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+                                                 currency TEXT NOT NULL,
+                                                 amount DECIMAL(10, 2) NOT NULL CHECK (amount <> 0),
+                                                 balance_before DECIMAL(10, 2) NOT NULL,
+                                                 balance_after DECIMAL(10, 2) NOT NULL,
+                                                 reason TEXT NOT NULL,
+                                                 operator TEXT NOT NULL,
+                                                 created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_wallet_adjustments_user_id ON wallet_adjustments (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS wallet_adjustments;
+DROP TABLE IF EXISTS jwt_keys;
+-- +goose StatementEnd
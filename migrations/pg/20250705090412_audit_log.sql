@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(16) NOT NULL DEFAULT 'user'
+    CONSTRAINT users_role_check CHECK (role IN ('user', 'admin'));
+
+-- журнал аудита только дополняется. Каждая запись хранит хеш предыдущей, поэтому изменение
+-- или удаление строки в обход триггера обнаруживает проверка цепочки (walletctl audit verify).
+CREATE TABLE IF NOT EXISTS audit_log(
+                                        id BIGSERIAL PRIMARY KEY,
+                                        action TEXT NOT NULL,
+                                        actor_id UUID,
+                                        actor TEXT NOT NULL,
+                                        target_id UUID,
+                                        request_id TEXT NOT NULL DEFAULT '',
+                                        ip TEXT NOT NULL DEFAULT '',
+                                        before JSONB,
+                                        after JSONB,
+                                        created_at TIMESTAMP NOT NULL,
+                                        prev_hash BYTEA NOT NULL,
+                                        hash BYTEA NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_audit_log_actor_id ON audit_log (actor_id, id DESC);
+CREATE INDEX idx_audit_log_target_id ON audit_log (target_id, id DESC);
+CREATE INDEX idx_audit_log_action ON audit_log (action, id DESC);
+
+CREATE OR REPLACE FUNCTION audit_log_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only: % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_immutable();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_immutable();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_immutable();
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+-- +goose StatementEnd
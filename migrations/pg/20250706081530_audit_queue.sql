@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- очередь журнала аудита: действие ставит запись в своей транзакции, а в цепочку audit_log
+-- её встраивает один писатель (audit.Service.StartChainWriter), поэтому операции не ждут блокировку цепочки.
+CREATE TABLE IF NOT EXISTS audit_queue(
+                                          id BIGSERIAL PRIMARY KEY,
+                                          action TEXT NOT NULL,
+                                          actor_id UUID,
+                                          actor TEXT NOT NULL,
+                                          target_id UUID,
+                                          request_id TEXT NOT NULL DEFAULT '',
+                                          ip TEXT NOT NULL DEFAULT '',
+                                          before JSONB,
+                                          after JSONB,
+                                          created_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_queue;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- сумма открытого ордера больше не списывается с кошелька, а держится в held, как у холдов:
+-- баланс по-прежнему показывает эти деньги, и закрыть аккаунт с открытым ордером нельзя.
+ALTER TABLE limit_orders ADD COLUMN IF NOT EXISTS wallet_id UUID REFERENCES wallets(id) ON DELETE CASCADE;
+
+UPDATE limit_orders o
+SET wallet_id = w.id
+FROM wallets w
+WHERE w.user_id = o.user_id AND w.currency = o.from_currency;
+
+ALTER TABLE limit_orders ALTER COLUMN wallet_id SET NOT NULL;
+
+-- резерв уже открытых ордеров был списан, он возвращается на баланс и переносится в held
+INSERT INTO transactions (wallet_id, amount, type, description, idempotency_key)
+SELECT wallet_id, amount, 'DEPOSIT', 'limit order reserve moved to hold', 'limit_order:' || id || ':reserve_to_hold'
+FROM limit_orders
+WHERE status = 'OPEN';
+
+UPDATE wallets w
+SET balance = w.balance + r.amount,
+    held = w.held + r.amount,
+    updated_at = CURRENT_TIMESTAMP
+FROM (SELECT wallet_id, SUM(amount) AS amount FROM limit_orders WHERE status = 'OPEN' GROUP BY wallet_id) r
+WHERE w.id = r.wallet_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE wallets w
+SET balance = w.balance - r.amount,
+    held = w.held - r.amount,
+    updated_at = CURRENT_TIMESTAMP
+FROM (SELECT wallet_id, SUM(amount) AS amount FROM limit_orders WHERE status = 'OPEN' GROUP BY wallet_id) r
+WHERE w.id = r.wallet_id;
+
+INSERT INTO transactions (wallet_id, amount, type, description, idempotency_key)
+SELECT wallet_id, amount, 'WITHDRAW', 'limit order hold moved to reserve', 'limit_order:' || id || ':hold_to_reserve'
+FROM limit_orders
+WHERE status = 'OPEN';
+
+ALTER TABLE limit_orders DROP COLUMN IF EXISTS wallet_id;
+-- +goose StatementEnd
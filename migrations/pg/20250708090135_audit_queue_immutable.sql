@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- запись очереди, которую ещё не встроили в цепочку, так же неизменяема, как audit_log: иначе её можно было бы
+-- поправить или удалить до того, как писатель цепочки посчитает хеш. Удалить можно только запись,
+-- копия которой уже лежит в audit_log (queue_id), — так писатель очищает очередь.
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS queue_id BIGINT UNIQUE;
+
+CREATE OR REPLACE FUNCTION audit_queue_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND EXISTS (SELECT 1 FROM audit_log WHERE queue_id = OLD.id) THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'audit_queue is append-only: % of a record not yet chained is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_queue_no_update
+    BEFORE UPDATE OR DELETE ON audit_queue
+    FOR EACH ROW EXECUTE FUNCTION audit_queue_immutable();
+
+CREATE TRIGGER audit_queue_no_truncate
+    BEFORE TRUNCATE ON audit_queue
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_queue_immutable();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS audit_queue_no_truncate ON audit_queue;
+DROP TRIGGER IF EXISTS audit_queue_no_update ON audit_queue;
+DROP FUNCTION IF EXISTS audit_queue_immutable();
+-- audit_log запрещает UPDATE и DELETE, колонка снимается вместе с данными
+ALTER TABLE audit_log DROP COLUMN IF EXISTS queue_id;
+-- +goose StatementEnd
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Sanchir01/currency-wallet/pkg/i18n"
+	"github.com/Sanchir01/currency-wallet/pkg/utils"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+)
+
+const ProblemContentType = "application/problem+json"
+
+// Problem — тело ошибки по RFC 7807, отдаётся клиентам, которые явно просят application/problem+json.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     string       `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError описывает нарушенное правило валидации для одного поля запроса.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// ValidationError — ошибка валидации со списком полей, для клиента это validation_failed.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Field+": "+f.Rule)
+	}
+	return utils.ErrorValidation.Message + ": " + strings.Join(parts, ", ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return utils.ErrorValidation
+}
+
+var statusByKind = map[utils.Kind]int{
+	utils.KindInternal:        http.StatusInternalServerError,
+	utils.KindInvalid:         http.StatusBadRequest,
+	utils.KindUnauthorized:    http.StatusUnauthorized,
+	utils.KindForbidden:       http.StatusForbidden,
+	utils.KindNotFound:        http.StatusNotFound,
+	utils.KindConflict:        http.StatusConflict,
+	utils.KindUnprocessable:   http.StatusUnprocessableEntity,
+	utils.KindTooManyRequests: http.StatusTooManyRequests,
+	utils.KindUnavailable:     http.StatusServiceUnavailable,
+	utils.KindTooLarge:        http.StatusRequestEntityTooLarge,
+}
+
+// HTTPStatus возвращает HTTP-статус для ошибки. Неизвестные ошибки считаются внутренними.
+func HTTPStatus(err error) int {
+	if status, ok := statusByKind[utils.AsError(err).Kind]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteError — единая точка превращения ошибки сервиса в HTTP-ответ.
+// Текст внутренних ошибок наружу не попадает, клиент получает только код internal_error.
+// Сообщение берётся из каталога на языке запроса.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	appErr := utils.AsError(err)
+	status := HTTPStatus(appErr)
+	locale := i18n.FromContext(r.Context())
+	message, ok := i18n.Lookup(locale, appErr.Code)
+	if !ok {
+		message = appErr.Message
+	}
+	var fields []FieldError
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		fields = localizeFields(locale, validationErr.Fields)
+	}
+
+	var retryErr *utils.RetryAfterError
+	if errors.As(err, &retryErr) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryErr.RetryAfter.Seconds()))))
+	}
+
+	if wantsProblem(r) {
+		w.Header().Set("Content-Type", ProblemContentType)
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(Problem{
+			Type:     "about:blank",
+			Title:    http.StatusText(status),
+			Status:   status,
+			Detail:   message,
+			Instance: middleware.GetReqID(r.Context()),
+			Code:     appErr.Code,
+			Errors:   fields,
+		})
+		return
+	}
+	render.Status(r, status)
+	resp := ErrorWithCode(appErr.Code, message)
+	resp.Fields = fields
+	render.JSON(w, r, resp)
+}
+
+func localizeFields(locale i18n.Locale, fields []FieldError) []FieldError {
+	out := make([]FieldError, len(fields))
+	for i, f := range fields {
+		key := "validation." + f.Rule
+		msg, ok := i18n.Lookup(locale, key)
+		if !ok {
+			key = "validation.invalid"
+			msg, _ = i18n.Lookup(locale, key)
+		}
+		// параметр подставляется, только если сообщение его ожидает
+		if strings.Contains(msg, "%s") {
+			f.Message = i18n.Message(locale, key, f.Param)
+		} else {
+			f.Message = msg
+		}
+		out[i] = f
+	}
+	return out
+}
+
+func wantsProblem(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), ProblemContentType)
+}
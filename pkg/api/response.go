@@ -1,8 +1,10 @@
 package api
 
 type Response struct {
-	Status string `json:"status"`
-	Error  string `json:"error,omitempty"`
+	Status string       `json:"status"`
+	Code   string       `json:"code,omitempty"`
+	Error  string       `json:"error,omitempty"`
+	Fields []FieldError `json:"fields,omitempty"`
 }
 
 const (
@@ -22,3 +24,11 @@ func Error(msg string) Response {
 		Error:  msg,
 	}
 }
+
+func ErrorWithCode(code, msg string) Response {
+	return Response{
+		Status: StatusError,
+		Code:   code,
+		Error:  msg,
+	}
+}
@@ -0,0 +1,26 @@
+// Package client — типизированный HTTP-клиент API кошелька для других сервисов.
+// Код в client.gen.go генерируется из api/openapi.json, руками его не правим: make openapi.
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.8.0 -config config.yaml ../../api/openapi.json
+
+import (
+	"context"
+	"net/http"
+)
+
+// WithAccessToken передаёт access-токен так же, как браузер: в cookie accessToken.
+func WithAccessToken(token string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.AddCookie(&http.Cookie{Name: "accessToken", Value: token})
+		return nil
+	}
+}
+
+// WithLanguage выбирает язык сообщений об ошибках.
+func WithLanguage(lang string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept-Language", lang)
+		return nil
+	}
+}
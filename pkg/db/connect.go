@@ -5,15 +5,18 @@ import (
 	"github.com/Sanchir01/currency-wallet/internal/config"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
+	"log/slog"
 	"os"
 )
 
 type Database struct {
 	PrimaryDB *pgxpool.Pool
 	RedisDB   *redis.Client
+	// Reads выбирает пул для чтений, без него все запросы идут в PrimaryDB
+	Reads *Router
 }
 
-func NewDataBases(cfg *config.Config, ctx context.Context) (*Database, error) {
+func NewDataBases(cfg *config.Config, ctx context.Context, l *slog.Logger) (*Database, error) {
 	pgxdb, err := PGXNew(cfg, ctx)
 	if err != nil {
 		return nil, err
@@ -24,10 +27,30 @@ func NewDataBases(cfg *config.Config, ctx context.Context) (*Database, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Database{PrimaryDB: pgxdb, RedisDB: redisdb}, nil
+	replicas, err := PGXReplicas(cfg, ctx)
+	if err != nil {
+		return nil, err
+	}
+	reads := NewRouter(pgxdb, replicas, redisdb, ReplicaOptions{
+		CheckInterval: cfg.DB.ReplicaCheckInterval,
+		MaxLag:        cfg.DB.ReplicaMaxLag,
+		StickyWindow:  cfg.DB.ReadYourWritesWindow,
+	}, l)
+	return &Database{PrimaryDB: pgxdb, RedisDB: redisdb, Reads: reads}, nil
+}
+
+// ReadRouter возвращает Reads, а если он не задан — маршрутизатор без реплик поверх PrimaryDB.
+func (databases *Database) ReadRouter() *Router {
+	if databases.Reads == nil {
+		databases.Reads = NewRouter(databases.PrimaryDB, nil, databases.RedisDB, ReplicaOptions{}, slog.Default())
+	}
+	return databases.Reads
 }
 
 func (databases *Database) Close() error {
+	if databases.Reads != nil {
+		databases.Reads.Close()
+	}
 	databases.PrimaryDB.Close()
 	return nil
 }
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/migrations"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// Migrator применяет встроенные миграции migrations/pg. Версии хранятся в goose_db_version,
+// как и у goose CLI, поэтому уже накатанные им базы продолжают с той же версии.
+type Migrator struct {
+	provider *goose.Provider
+	sqlDB    *sql.DB
+	log      *slog.Logger
+}
+
+func NewMigrator(pool *pgxpool.Pool, l *slog.Logger) (*Migrator, error) {
+	fsys, err := fs.Sub(migrations.Postgres, "pg")
+	if err != nil {
+		return nil, err
+	}
+	// advisory lock не даёт нескольким экземплярам сервиса накатывать миграции одновременно
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB := stdlib.OpenDBFromPool(pool)
+	provider, err := goose.NewProvider(goose.DialectPostgres, sqlDB, fsys, goose.WithSessionLocker(locker))
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+	return &Migrator{provider: provider, sqlDB: sqlDB, log: l}, nil
+}
+
+// Up накатывает все ещё не применённые миграции.
+func (m *Migrator) Up(ctx context.Context) error {
+	results, err := m.provider.Up(ctx)
+	m.logResults(results)
+	return err
+}
+
+// Down откатывает последнюю применённую миграцию.
+func (m *Migrator) Down(ctx context.Context) error {
+	result, err := m.provider.Down(ctx)
+	if result != nil {
+		m.logResults([]*goose.MigrationResult{result})
+	}
+	return err
+}
+
+// DownTo откатывает миграции новее version, DownTo(ctx, 0) откатывает все.
+func (m *Migrator) DownTo(ctx context.Context, version int64) error {
+	results, err := m.provider.DownTo(ctx, version)
+	m.logResults(results)
+	return err
+}
+
+// Status печатает в w состояние каждой миграции.
+func (m *Migrator) Status(ctx context.Context, w io.Writer) error {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "APPLIED AT\tMIGRATION")
+	for _, s := range statuses {
+		appliedAt := "pending"
+		if s.State == goose.StateApplied {
+			appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", appliedAt, s.Source.Path)
+	}
+	return tw.Flush()
+}
+
+// Close закрывает обёртку database/sql. Сам пул pgx остаётся открытым.
+func (m *Migrator) Close() error {
+	return m.sqlDB.Close()
+}
+
+func (m *Migrator) logResults(results []*goose.MigrationResult) {
+	for _, r := range results {
+		if r.Error != nil {
+			m.log.Error("migration failed", slog.String("migration", r.Source.Path),
+				slog.String("direction", r.Direction), slog.String("error", r.Error.Error()))
+			continue
+		}
+		m.log.Info("migration applied", slog.String("migration", r.Source.Path),
+			slog.String("direction", r.Direction), slog.Duration("duration", r.Duration))
+	}
+}
@@ -14,7 +14,6 @@ import (
 func PGXNew(cfg *config.Config, ctx context.Context) (*pgxpool.Pool, error) {
 	var dsn string
 	passwordpg := os.Getenv("DB_PASSWORD_PROD")
-	fmt.Println(passwordpg)
 	switch cfg.Env {
 	case "development":
 		dsn = fmt.Sprintf(
@@ -51,3 +50,34 @@ func PGXNew(cfg *config.Config, ctx context.Context) (*pgxpool.Pool, error) {
 	}
 	return pool, nil
 }
+
+// PGXReplicas создаёт пулы реплик из database.replicas по их host:port. Пул подключается лениво,
+// поэтому недоступная при старте реплика не мешает запуску: её состояние отслеживает Router.
+func PGXReplicas(cfg *config.Config, ctx context.Context) (map[string]*pgxpool.Pool, error) {
+	pools := make(map[string]*pgxpool.Pool, len(cfg.DB.Replicas))
+	for _, replica := range cfg.DB.Replicas {
+		var dsn string
+		switch cfg.Env {
+		case "development":
+			dsn = fmt.Sprintf(
+				"postgres://postgres:postgres@%s:%s/currency-wallet?sslmode=disable",
+				replica.Host, replica.Port,
+			)
+		case "production":
+			dsn = fmt.Sprintf(
+				"postgresql://%s:%s@%s:%s/%s",
+				cfg.DB.User, os.Getenv("DB_PASSWORD_PROD"),
+				replica.Host, replica.Port, cfg.DB.Database,
+			)
+		}
+		pool, err := pgxpool.New(ctx, dsn)
+		if err != nil {
+			for _, p := range pools {
+				p.Close()
+			}
+			return nil, fmt.Errorf("replica %s:%s: %w", replica.Host, replica.Port, err)
+		}
+		pools[replica.Host+":"+replica.Port] = pool
+	}
+	return pools, nil
+}
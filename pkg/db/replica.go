@@ -0,0 +1,210 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+func init() {
+	prometheus.MustRegister(replicaHealthy)
+	prometheus.MustRegister(replicaLag)
+}
+
+var replicaHealthy = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "db",
+		Name:      "replica_healthy",
+		Help:      "Whether reads are routed to the replica: 1 healthy, 0 unavailable or lagging.",
+	},
+	[]string{"replica"},
+)
+
+var replicaLag = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "db",
+		Name:      "replica_lag_seconds",
+		Help:      "Replication lag of the replica at the last health check.",
+	},
+	[]string{"replica"},
+)
+
+// replicaLagQuery — отставание реплики. Если всё полученное WAL уже применено, реплика догнала основную базу,
+// даже если последняя транзакция была давно: иначе простой основной базы выглядел бы как отставание.
+const replicaLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+type ReplicaOptions struct {
+	// CheckInterval — как часто проверяется доступность и отставание реплик
+	CheckInterval time.Duration
+	// MaxLag — реплика с большим отставанием исключается из чтения до следующей проверки
+	MaxLag time.Duration
+	// StickyWindow — сколько после изменения данных чтения пользователя идут в основную базу
+	StickyWindow time.Duration
+}
+
+type replica struct {
+	name    string
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+// Router выбирает пул для запросов только на чтение. Чтения распределяются по здоровым
+// репликам по кругу и уходят в основную базу, если реплик нет, все они недоступны или отстают,
+// или контекст помечен WithPrimary.
+type Router struct {
+	primary  *pgxpool.Pool
+	replicas []*replica
+	next     atomic.Uint64
+	recent   *redis.Client
+	opts     ReplicaOptions
+	log      *slog.Logger
+}
+
+// NewRouter — replicas с ключами-именами для логов и метрик. Без реплик все чтения идут в primary.
+// Отметки о записях пользователей хранятся в recent, чтобы их видели все экземпляры сервиса.
+func NewRouter(primary *pgxpool.Pool, replicas map[string]*pgxpool.Pool, recent *redis.Client, opts ReplicaOptions, log *slog.Logger) *Router {
+	if opts.CheckInterval <= 0 {
+		opts.CheckInterval = 5 * time.Second
+	}
+	if opts.StickyWindow <= 0 {
+		opts.StickyWindow = 5 * time.Second
+	}
+	r := &Router{primary: primary, recent: recent, opts: opts, log: log}
+	for name, pool := range replicas {
+		r.replicas = append(r.replicas, &replica{name: name, pool: pool})
+	}
+	return r
+}
+
+// Primary — основная база, для записей и чтений внутри транзакций.
+func (r *Router) Primary() *pgxpool.Pool {
+	return r.primary
+}
+
+// Reader возвращает, через что читать. Внутри TxManager.Do это сама транзакция из ctx: так чтения видят
+// её незакоммиченные изменения и не занимают второе соединение пула.
+func (r *Router) Reader(ctx context.Context) Querier {
+	return Conn(ctx, r.readPool(ctx))
+}
+
+func (r *Router) readPool(ctx context.Context) *pgxpool.Pool {
+	if len(r.replicas) == 0 || UsesPrimary(ctx) {
+		return r.primary
+	}
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		rep := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+		if rep.healthy.Load() {
+			return rep.pool
+		}
+	}
+	return r.primary
+}
+
+// MarkWrite отмечает, что пользователь только что изменил данные: StickyWindow его чтения
+// идут в основную базу, пока реплики не догонят.
+func (r *Router) MarkWrite(ctx context.Context, userID uuid.UUID) {
+	if len(r.replicas) == 0 || r.recent == nil {
+		return
+	}
+	if err := r.recent.Set(ctx, recentWriteKey(userID), 1, r.opts.StickyWindow).Err(); err != nil {
+		r.log.Error("failed to mark recent write", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+	}
+}
+
+// RecentWrite сообщает, менял ли пользователь данные в последние StickyWindow.
+// Если Redis недоступен, считается, что менял: лишнее чтение с основной базы лучше устаревших данных.
+func (r *Router) RecentWrite(ctx context.Context, userID uuid.UUID) bool {
+	if len(r.replicas) == 0 || r.recent == nil {
+		return false
+	}
+	n, err := r.recent.Exists(ctx, recentWriteKey(userID)).Result()
+	if err != nil {
+		r.log.Error("failed to check recent write", slog.String("user_id", userID.String()), slog.String("error", err.Error()))
+		return true
+	}
+	return n > 0
+}
+
+// StartHealthCheck сразу проверяет реплики и дальше повторяет проверку каждые CheckInterval до отмены ctx.
+// До первой проверки чтения идут в основную базу.
+func (r *Router) StartHealthCheck(ctx context.Context) {
+	if len(r.replicas) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(r.opts.CheckInterval)
+		defer ticker.Stop()
+		for {
+			r.check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (r *Router) check(ctx context.Context) {
+	for _, rep := range r.replicas {
+		healthy := r.checkReplica(ctx, rep)
+		if rep.healthy.Swap(healthy) != healthy {
+			if healthy {
+				r.log.Info("replica is back in rotation", slog.String("replica", rep.name))
+			} else {
+				r.log.Warn("replica is out of rotation", slog.String("replica", rep.name))
+			}
+		}
+		gauge := 0.0
+		if healthy {
+			gauge = 1
+		}
+		replicaHealthy.WithLabelValues(rep.name).Set(gauge)
+	}
+}
+
+func (r *Router) checkReplica(ctx context.Context, rep *replica) bool {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.CheckInterval)
+	defer cancel()
+	var lag float64
+	if err := rep.pool.QueryRow(ctx, replicaLagQuery).Scan(&lag); err != nil {
+		r.log.Error("replica health check failed", slog.String("replica", rep.name), slog.String("error", err.Error()))
+		return false
+	}
+	replicaLag.WithLabelValues(rep.name).Set(lag)
+	return r.opts.MaxLag <= 0 || lag <= r.opts.MaxLag.Seconds()
+}
+
+// Close закрывает пулы реплик, основная база закрывается Database.Close.
+func (r *Router) Close() {
+	for _, rep := range r.replicas {
+		rep.pool.Close()
+	}
+}
+
+func recentWriteKey(userID uuid.UUID) string {
+	return "db:recent_write:" + userID.String()
+}
+
+type primaryKey struct{}
+
+// WithPrimary направляет все чтения в рамках ctx в основную базу.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsesPrimary сообщает, помечен ли ctx через WithPrimary.
+func UsesPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
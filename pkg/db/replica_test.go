@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// lazyPool — пул без подключения: pgxpool подключается только при первом запросе.
+func lazyPool(t *testing.T, host string) *pgxpool.Pool {
+	t.Helper()
+	pool, err := pgxpool.New(context.Background(), "postgres://postgres@"+host+":5432/test")
+	if err != nil {
+		t.Fatalf("pool %s: %v", host, err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestRouterReader(t *testing.T) {
+	ctx := context.Background()
+	primary := lazyPool(t, "primary")
+	a, b := lazyPool(t, "replica-a"), lazyPool(t, "replica-b")
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	if got := NewRouter(primary, nil, nil, ReplicaOptions{}, log).Reader(ctx); got != primary {
+		t.Error("router without replicas does not read from primary")
+	}
+
+	r := NewRouter(primary, map[string]*pgxpool.Pool{"a": a, "b": b}, nil, ReplicaOptions{}, log)
+	if got := r.Reader(ctx); got != primary {
+		t.Error("reads go to a replica before the first health check")
+	}
+	for _, rep := range r.replicas {
+		rep.healthy.Store(true)
+	}
+	seen := map[Querier]int{}
+	for range 4 {
+		seen[r.Reader(ctx)]++
+	}
+	if seen[a] != 2 || seen[b] != 2 {
+		t.Errorf("reads are not spread over replicas: a=%d b=%d primary=%d", seen[a], seen[b], seen[primary])
+	}
+	if got := r.Reader(WithPrimary(ctx)); got != primary {
+		t.Error("WithPrimary context reads from a replica")
+	}
+
+	for _, rep := range r.replicas {
+		rep.healthy.Store(rep.pool == b)
+	}
+	for range 3 {
+		if got := r.Reader(ctx); got != b {
+			t.Fatal("read routed to an unhealthy replica")
+		}
+	}
+	r.replicas[0].healthy.Store(false)
+	r.replicas[1].healthy.Store(false)
+	if got := r.Reader(ctx); got != primary {
+		t.Error("no fallback to primary when all replicas are down")
+	}
+}
+
+func TestRouterRecentWrite(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	r := NewRouter(lazyPool(t, "primary"), map[string]*pgxpool.Pool{"a": lazyPool(t, "replica-a")}, rdb,
+		ReplicaOptions{StickyWindow: 3 * time.Second}, log)
+	alice, bob := uuid.New(), uuid.New()
+
+	r.MarkWrite(ctx, alice)
+	if !r.RecentWrite(ctx, alice) {
+		t.Error("write is not remembered")
+	}
+	if r.RecentWrite(ctx, bob) {
+		t.Error("write of another user is remembered")
+	}
+	mr.FastForward(4 * time.Second)
+	if r.RecentWrite(ctx, alice) {
+		t.Error("write is remembered after the sticky window")
+	}
+
+	mr.Close()
+	if !r.RecentWrite(ctx, bob) {
+		t.Error("redis failure does not send reads to primary")
+	}
+}
+
+// TestReaderInsideTransaction проверяет на настоящей базе (INTEGRATION_DATABASE_URL), что чтение
+// внутри TxManager.Do видит незакоммиченную запись своей транзакции.
+func TestReaderInsideTransaction(t *testing.T) {
+	url := os.Getenv("INTEGRATION_DATABASE_URL")
+	if url == "" {
+		t.Skip("INTEGRATION_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	// реплика здорова, но чтение в транзакции всё равно должно идти через саму транзакцию
+	r := NewRouter(pool, map[string]*pgxpool.Pool{"a": lazyPool(t, "replica-a")}, nil, ReplicaOptions{}, log)
+	r.replicas[0].healthy.Store(true)
+
+	table := "reader_tx_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := pool.Exec(ctx, "CREATE TABLE "+table+" (id int)"); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	t.Cleanup(func() { _, _ = pool.Exec(context.Background(), "DROP TABLE "+table) })
+
+	errRollback := errors.New("rollback")
+	err = NewTxManager(pool, log).Do(ctx, func(ctx context.Context) error {
+		if _, err := Conn(ctx, pool).Exec(ctx, "INSERT INTO "+table+" VALUES (1)"); err != nil {
+			return err
+		}
+		var n int
+		if err := r.Reader(ctx).QueryRow(ctx, "SELECT count(*) FROM "+table).Scan(&n); err != nil {
+			return err
+		}
+		if n != 1 {
+			t.Errorf("read inside transaction sees %d rows, want the uncommitted insert", n)
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("Do: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrNoTransaction — метод репозитория, которому нужна транзакция, вызван вне TxManager.Do.
+var ErrNoTransaction = errors.New("db: no transaction in context")
+
+// Querier — общие методы пула и транзакции, через них репозитории выполняют запросы.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type txKey struct{}
+
+// Tx возвращает транзакцию, которую TxManager положил в ctx.
+func Tx(ctx context.Context) (pgx.Tx, error) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	if !ok {
+		return nil, ErrNoTransaction
+	}
+	return tx, nil
+}
+
+func inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return ok
+}
+
+// Conn возвращает транзакцию из ctx, а вне транзакции — pool.
+func Conn(ctx context.Context, pool *pgxpool.Pool) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return pool
+}
+
+// WithTx кладёт в ctx уже открытую транзакцию: Do и Conn работают в ней, не открывая новую.
+// Нужна тестам сервисов, где репозитории подменены и настоящей базы нет.
+func WithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxOptions — параметры транзакции TxManager.DoWithOptions.
+type TxOptions struct {
+	IsoLevel   pgx.TxIsoLevel
+	AccessMode pgx.TxAccessMode
+	// Attempts — сколько раз выполнить функцию, если транзакция падает с конфликтом сериализации
+	// или дедлоком. 0 — значение по умолчанию TxManager
+	Attempts int
+}
+
+// TxManager выполняет функции в транзакции основной базы. Транзакция передаётся через контекст,
+// поэтому вложенные вызовы Do и методы репозиториев работают в той же транзакции.
+type TxManager struct {
+	pool     *pgxpool.Pool
+	attempts int
+	log      *slog.Logger
+}
+
+// defaultTxAttempts — попыток на транзакцию, если TxOptions.Attempts не задан.
+const defaultTxAttempts = 3
+
+// NewTxManager — менеджер транзакций поверх pool основной базы.
+func NewTxManager(pool *pgxpool.Pool, log *slog.Logger) *TxManager {
+	return &TxManager{pool: pool, attempts: defaultTxAttempts, log: log}
+}
+
+// Do выполняет fn в транзакции READ COMMITTED, см. DoWithOptions.
+func (m *TxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.DoWithOptions(ctx, TxOptions{}, fn)
+}
+
+// DoWithOptions выполняет fn в транзакции и коммитит её, если fn вернула nil. При ошибке или панике
+// транзакция откатывается. Конфликт сериализации (40001) и дедлок (40P01) повторяют fn в новой
+// транзакции, поэтому побочные эффекты вне базы — письма, события брокера — выполняются после Do.
+// Если в ctx уже есть транзакция, fn выполняется в ней, а коммит и повторы остаются за внешним вызовом.
+func (m *TxManager) DoWithOptions(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error {
+	if inTx(ctx) {
+		return fn(ctx)
+	}
+	attempts := opts.Attempts
+	if attempts <= 0 {
+		attempts = m.attempts
+	}
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = m.run(ctx, opts, fn)
+		if err == nil || attempt >= attempts || !retryable(err) {
+			return err
+		}
+		m.log.Warn("transaction conflict, retrying", slog.Int("attempt", attempt), slog.String("error", err.Error()))
+		// случайная пауза разводит конкурирующие транзакции, чтобы они не столкнулись снова
+		delay := time.Duration(attempt) * (5*time.Millisecond + rand.N(20*time.Millisecond))
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (m *TxManager) run(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) (err error) {
+	tx, err := m.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: opts.IsoLevel, AccessMode: opts.AccessMode})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(context.WithoutCancel(ctx))
+			panic(p)
+		}
+		if err != nil {
+			// откат выполняется и для отменённого ctx, иначе соединение вернётся в пул с открытой транзакцией
+			if rollbackErr := tx.Rollback(context.WithoutCancel(ctx)); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+				m.log.Error("rollback error", slog.String("error", rollbackErr.Error()))
+				err = errors.Join(err, rollbackErr)
+			}
+		}
+	}()
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func retryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == "40001" || pgErr.Code == "40P01"
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// fakeTx — транзакция, которую TxManager находит в контексте. Методы pgx.Tx тесту не нужны.
+type fakeTx struct{ pgx.Tx }
+
+func TestTxFromContext(t *testing.T) {
+	ctx := context.Background()
+	pool := lazyPool(t, "primary")
+
+	if _, err := Tx(ctx); !errors.Is(err, ErrNoTransaction) {
+		t.Errorf("Tx outside transaction: got %v, want ErrNoTransaction", err)
+	}
+	if got := Conn(ctx, pool); got != pool {
+		t.Error("Conn outside transaction does not return pool")
+	}
+
+	tx := &fakeTx{}
+	txCtx := WithTx(ctx, tx)
+	if got, err := Tx(txCtx); err != nil || got != tx {
+		t.Errorf("Tx inside transaction: got %v, %v", got, err)
+	}
+	if got := Conn(txCtx, pool); got != tx {
+		t.Error("Conn inside transaction does not return the transaction")
+	}
+
+	// вложенный Do выполняется в транзакции из ctx и не открывает новую: у менеджера нет пула
+	m := &TxManager{attempts: defaultTxAttempts, log: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	calls := 0
+	err := m.Do(txCtx, func(ctx context.Context) error {
+		calls++
+		if got, _ := Tx(ctx); got != tx {
+			t.Error("nested Do runs outside the outer transaction")
+		}
+		return nil
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("nested Do: calls=%d err=%v", calls, err)
+	}
+
+	r := NewRouter(pool, map[string]*pgxpool.Pool{"a": lazyPool(t, "replica-a")}, nil, ReplicaOptions{}, m.log)
+	r.replicas[0].healthy.Store(true)
+	if got := r.Reader(txCtx); got != tx {
+		t.Error("read inside transaction does not use the transaction")
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{&pgconn.PgError{Code: "40001"}, true},
+		{&pgconn.PgError{Code: "40P01"}, true},
+		{fmt.Errorf("lock order: %w", &pgconn.PgError{Code: "40001"}), true},
+		{&pgconn.PgError{Code: "23505"}, false},
+		{errors.New("connection reset"), false},
+	}
+	for _, c := range cases {
+		if got := retryable(c.err); got != c.want {
+			t.Errorf("retryable(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
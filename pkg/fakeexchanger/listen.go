@@ -0,0 +1,41 @@
+package fakeexchanger
+
+import (
+	"context"
+	"net"
+
+	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// BufconnAddr — адрес для grpc.NewClient вместе с опциями из Bufconn. Схема passthrough
+// отдаёт адрес диалеру как есть, без DNS.
+const BufconnAddr = "passthrough:///fake-exchanger"
+
+const bufSize = 1 << 20
+
+// NewGRPCServer регистрирует s на новом gRPC-сервере.
+func NewGRPCServer(s *Server, opts ...grpc.ServerOption) *grpc.Server {
+	gs := grpc.NewServer(opts...)
+	walletsv1.RegisterExchangeServiceServer(gs, s)
+	return gs
+}
+
+// Bufconn запускает s в памяти процесса и возвращает опции подключения к нему по адресу BufconnAddr.
+// stop останавливает сервер.
+func Bufconn(s *Server) (dialOpts []grpc.DialOption, stop func()) {
+	lis := bufconn.Listen(bufSize)
+	gs := NewGRPCServer(s)
+	go func() {
+		_ = gs.Serve(lis)
+	}()
+	dialOpts = []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	return dialOpts, gs.Stop
+}
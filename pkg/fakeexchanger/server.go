@@ -0,0 +1,147 @@
+// Package fakeexchanger — поддельный сервис обменника walletsv1.ExchangeService для локальной
+// разработки и тестов. Курсы задаются явно, сбои и задержки можно заскриптовать.
+package fakeexchanger
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Step — поведение сервера на одном вызове: задержка перед ответом и, если Code не OK, ошибка с этим кодом.
+type Step struct {
+	Delay time.Duration
+	Code  codes.Code
+}
+
+// Server отвечает курсами относительно общей базы: единица базы стоит rates[c] единиц валюты c,
+// курс пары from→to равен rates[to] / rates[from].
+//
+// Каждый вызов сначала берёт очередной шаг сценария (Script), а когда сценарий закончился —
+// поведение по умолчанию: задержка Latency и сбой с вероятностью FailRate.
+type Server struct {
+	walletsv1.UnimplementedExchangeServiceServer
+
+	mu       sync.Mutex
+	rates    map[string]float32
+	script   []Step
+	latency  time.Duration
+	failRate float64
+	failCode codes.Code
+	calls    atomic.Int64
+}
+
+func New(rates map[string]float32) *Server {
+	s := &Server{failCode: codes.Unavailable}
+	s.SetRates(rates)
+	return s
+}
+
+// SetRates заменяет курсы, следующие вызовы получают новые значения.
+func (s *Server) SetRates(rates map[string]float32) {
+	copied := make(map[string]float32, len(rates))
+	for currency, v := range rates {
+		copied[currency] = v
+	}
+	s.mu.Lock()
+	s.rates = copied
+	s.mu.Unlock()
+}
+
+// SetLatency задаёт задержку каждого ответа после окончания сценария.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	s.latency = d
+	s.mu.Unlock()
+}
+
+// SetFailRate задаёт долю вызовов, которые после окончания сценария завершаются ошибкой code.
+func (s *Server) SetFailRate(rate float64, code codes.Code) {
+	s.mu.Lock()
+	s.failRate, s.failCode = rate, code
+	s.mu.Unlock()
+}
+
+// Script добавляет шаги в конец сценария.
+func (s *Server) Script(steps ...Step) {
+	s.mu.Lock()
+	s.script = append(s.script, steps...)
+	s.mu.Unlock()
+}
+
+// FailNext — сценарий из n ошибок с кодом code подряд.
+func (s *Server) FailNext(n int, code codes.Code) {
+	steps := make([]Step, n)
+	for i := range steps {
+		steps[i] = Step{Code: code}
+	}
+	s.Script(steps...)
+}
+
+// Calls возвращает число вызовов с момента запуска, включая завершившиеся ошибкой.
+func (s *Server) Calls() int64 {
+	return s.calls.Load()
+}
+
+func (s *Server) GetExchangeRates(ctx context.Context, _ *emptypb.Empty) (*walletsv1.ExchangeRatesResponse, error) {
+	rates, err := s.call(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &walletsv1.ExchangeRatesResponse{Rates: rates}, nil
+}
+
+func (s *Server) GetExchangeRateForCurrency(ctx context.Context, req *walletsv1.CurrencyRequest) (*walletsv1.ExchangeRateResponse, error) {
+	rates, err := s.call(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, okFrom := rates[req.FromCurrency]
+	to, okTo := rates[req.ToCurrency]
+	if !okFrom || !okTo || from <= 0 {
+		return nil, status.Errorf(codes.NotFound, "no rate for %s/%s", req.FromCurrency, req.ToCurrency)
+	}
+	return &walletsv1.ExchangeRateResponse{
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Rate:         to / from,
+	}, nil
+}
+
+// call применяет очередной шаг и возвращает снимок курсов.
+func (s *Server) call(ctx context.Context) (map[string]float32, error) {
+	s.calls.Add(1)
+	s.mu.Lock()
+	step := Step{Delay: s.latency, Code: codes.OK}
+	if len(s.script) > 0 {
+		step, s.script = s.script[0], s.script[1:]
+	} else if s.failRate > 0 && rand.Float64() < s.failRate {
+		step.Code = s.failCode
+	}
+	rates := make(map[string]float32, len(s.rates))
+	for currency, v := range s.rates {
+		rates[currency] = v
+	}
+	s.mu.Unlock()
+
+	if step.Delay > 0 {
+		timer := time.NewTimer(step.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+	if step.Code != codes.OK {
+		return nil, status.Errorf(step.Code, "scripted failure")
+	}
+	return rates, nil
+}
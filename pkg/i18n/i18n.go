@@ -0,0 +1,121 @@
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Locale string
+
+const (
+	English Locale = "en"
+	Russian Locale = "ru"
+
+	Default = English
+)
+
+type ctxKey struct{}
+
+//go:embed locales/*.json
+var bundles embed.FS
+
+// catalog хранит сообщения по локали и стабильному коду ошибки или уведомления.
+var catalog = mustLoad()
+
+func mustLoad() map[Locale]map[string]string {
+	entries, err := bundles.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	c := make(map[Locale]map[string]string, len(entries))
+	for _, e := range entries {
+		data, err := bundles.ReadFile(path.Join("locales", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: bundle %s: %v", e.Name(), err))
+		}
+		c[Locale(strings.TrimSuffix(e.Name(), ".json"))] = messages
+	}
+	return c
+}
+
+// Parse приводит тег вида "ru-RU" к поддерживаемой локали.
+func Parse(tag string) (Locale, bool) {
+	base, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	l := Locale(strings.ToLower(base))
+	_, ok := catalog[l]
+	return l, ok
+}
+
+// FromAcceptLanguage выбирает поддерживаемую локаль с наибольшим весом q.
+func FromAcceptLanguage(header string) Locale {
+	type candidate struct {
+		locale Locale
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		l, ok := Parse(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{l, q})
+		}
+	}
+	if len(candidates) == 0 {
+		return Default
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].locale
+}
+
+func WithLocale(ctx context.Context, l Locale) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+func FromContext(ctx context.Context) Locale {
+	if l, ok := ctx.Value(ctxKey{}).(Locale); ok {
+		return l
+	}
+	return Default
+}
+
+// Lookup ищет сообщение в локали, затем в Default.
+func Lookup(l Locale, code string) (string, bool) {
+	if msg, ok := catalog[l][code]; ok {
+		return msg, true
+	}
+	msg, ok := catalog[Default][code]
+	return msg, ok
+}
+
+// Message форматирует сообщение по коду. Если кода нет ни в одном бандле, возвращается сам код.
+func Message(l Locale, code string, args ...any) string {
+	msg, ok := Lookup(l, code)
+	if !ok {
+		return code
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
@@ -0,0 +1,145 @@
+package pdf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Размеры страницы A4 в пунктах и параметры моноширинного текста.
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	margin       = 40
+	fontSize     = 8
+	leading      = 11
+	LinesPerPage = (pageHeight - 2*margin) / leading
+)
+
+// Номера объектов, которые известны заранее. Страницы и их содержимое получают номера по порядку.
+const (
+	catalogObj = 1
+	pagesObj   = 2
+	fontObj    = 3
+)
+
+// Writer пишет простой текстовый PDF моноширинным шрифтом. Страница уходит в поток,
+// как только заполнится, в памяти остаются только смещения объектов и номера страниц,
+// поэтому размер документа не ограничен памятью.
+// Встроенный шрифт Courier покрывает только латиницу, остальные символы заменяются на '?'.
+type Writer struct {
+	w       *bufio.Writer
+	offset  int64
+	offsets []int64
+	pages   []int
+	lines   []string
+	err     error
+}
+
+func NewWriter(w io.Writer) *Writer {
+	p := &Writer{
+		w:       bufio.NewWriter(w),
+		offsets: make([]int64, fontObj+1),
+	}
+	p.printf("%%PDF-1.4\n%%\xe2\xe3\xcf\xd3\n")
+	p.beginObj(fontObj)
+	p.printf("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>\nendobj\n")
+	return p
+}
+
+// Line добавляет строку на текущую страницу и начинает новую страницу, если текущая заполнена.
+func (p *Writer) Line(text string) error {
+	p.lines = append(p.lines, text)
+	if len(p.lines) == LinesPerPage {
+		p.flushPage()
+	}
+	return p.err
+}
+
+// Close дописывает последнюю страницу, дерево страниц и таблицу ссылок.
+// Нижележащий поток не закрывается.
+func (p *Writer) Close() error {
+	if len(p.lines) > 0 || len(p.pages) == 0 {
+		p.flushPage()
+	}
+	p.beginObj(pagesObj)
+	kids := make([]string, len(p.pages))
+	for i, n := range p.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", n)
+	}
+	p.printf("<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), len(p.pages))
+	p.beginObj(catalogObj)
+	p.printf("<< /Type /Catalog /Pages %d 0 R >>\nendobj\n", pagesObj)
+
+	xref := p.offset
+	p.printf("xref\n0 %d\n0000000000 65535 f \n", len(p.offsets))
+	for _, off := range p.offsets[1:] {
+		p.printf("%010d 00000 n \n", off)
+	}
+	p.printf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(p.offsets), catalogObj, xref)
+	if p.err != nil {
+		return p.err
+	}
+	return p.w.Flush()
+}
+
+func (p *Writer) flushPage() {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, leading, margin, pageHeight-margin)
+	for _, line := range p.lines {
+		content.WriteString("(")
+		content.WriteString(escape(line))
+		content.WriteString(") Tj T*\n")
+	}
+	content.WriteString("ET\n")
+
+	contentObj := p.newObj()
+	p.printf("<< /Length %d >>\nstream\n%s\nendstream\nendobj\n", content.Len(), content.String())
+	pageObj := p.newObj()
+	p.printf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R >> >> /Contents %d 0 R >>\nendobj\n",
+		pagesObj, pageWidth, pageHeight, fontObj, contentObj)
+	p.pages = append(p.pages, pageObj)
+	p.lines = p.lines[:0]
+	if p.err == nil {
+		p.err = p.w.Flush()
+	}
+}
+
+func (p *Writer) newObj() int {
+	p.offsets = append(p.offsets, 0)
+	n := len(p.offsets) - 1
+	p.beginObj(n)
+	return n
+}
+
+func (p *Writer) beginObj(n int) {
+	p.offsets[n] = p.offset
+	p.printf("%d 0 obj\n", n)
+}
+
+func (p *Writer) printf(format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(p.w, format, args...)
+	p.offset += int64(n)
+	p.err = err
+}
+
+// escape экранирует строку PDF и заменяет символы вне ASCII.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,190 @@
+package grpcapp
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BreakerState int
+
+const (
+	StateClosed BreakerState = iota
+	StateHalfOpen
+	StateOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+// ErrCircuitOpen возвращается без обращения к серверу, пока цепь разомкнута.
+// Код Unavailable, чтобы вызывающий код обрабатывал его как обычную недоступность сервиса.
+var ErrCircuitOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+type BreakerOptions struct {
+	// FailureThreshold — сколько неудачных вызовов подряд размыкают цепь
+	FailureThreshold int
+	// OpenTimeout — сколько цепь остаётся разомкнутой до пробных вызовов
+	OpenTimeout time.Duration
+	// HalfOpenMaxCalls — сколько пробных вызовов пропускается, цепь замыкается, если все они успешны
+	HalfOpenMaxCalls int
+}
+
+// CircuitBreaker перестаёт обращаться к серверу после серии сбоев, чтобы запросы не ждали
+// заведомо недоступный сервис. Через OpenTimeout пропускает пробные вызовы и по их итогу
+// замыкает цепь или снова размыкает.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	name      string
+	opts      BreakerOptions
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	probes    int
+	successes int
+	log       *slog.Logger
+}
+
+func NewCircuitBreaker(name string, opts BreakerOptions, log *slog.Logger) *CircuitBreaker {
+	if opts.FailureThreshold < 1 {
+		opts.FailureThreshold = 1
+	}
+	if opts.HalfOpenMaxCalls < 1 {
+		opts.HalfOpenMaxCalls = 1
+	}
+	b := &CircuitBreaker{name: name, opts: opts, log: log}
+	breakerState.WithLabelValues(name).Set(float64(StateClosed))
+	return b
+}
+
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// UnaryClientInterceptor пропускает вызов через предохранитель. Ставится снаружи повторов,
+// чтобы вызов со всеми попытками считался одним исходом.
+func (b *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := b.allow(); err != nil {
+			breakerRejected.WithLabelValues(b.name).Inc()
+			return err
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(err)
+		return err
+	}
+}
+
+func (b *CircuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < b.opts.OpenTimeout {
+			return ErrCircuitOpen
+		}
+		b.setState(StateHalfOpen)
+		b.probes, b.successes = 0, 0
+		fallthrough
+	case StateHalfOpen:
+		if b.probes >= b.opts.HalfOpenMaxCalls {
+			return ErrCircuitOpen
+		}
+		b.probes++
+	}
+	return nil
+}
+
+func (b *CircuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	outcome := classify(err)
+	switch b.state {
+	case StateClosed:
+		switch outcome {
+		case outcomeFailure:
+			b.failures++
+			if b.failures >= b.opts.FailureThreshold {
+				b.open()
+			}
+		case outcomeSuccess:
+			b.failures = 0
+		}
+	case StateHalfOpen:
+		switch outcome {
+		case outcomeFailure:
+			b.open()
+		case outcomeSuccess:
+			b.successes++
+			if b.successes >= b.opts.HalfOpenMaxCalls {
+				b.failures = 0
+				b.setState(StateClosed)
+			}
+		default:
+			// отменённый клиентом вызов ничего не говорит о сервере, слот пробы освобождается
+			b.probes--
+		}
+	}
+}
+
+func (b *CircuitBreaker) open() {
+	b.openedAt = time.Now()
+	b.setState(StateOpen)
+}
+
+func (b *CircuitBreaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	b.log.Warn("circuit breaker state changed",
+		slog.String("target", b.name),
+		slog.String("from", b.state.String()),
+		slog.String("to", state.String()),
+	)
+	b.state = state
+	breakerState.WithLabelValues(b.name).Set(float64(state))
+}
+
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	// outcomeNeutral — ошибка клиента или отмена, на состояние сервера не указывает
+	outcomeNeutral
+)
+
+func classify(err error) outcome {
+	if err == nil {
+		return outcomeSuccess
+	}
+	if errors.Is(err, context.Canceled) {
+		return outcomeNeutral
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted,
+		codes.Internal, codes.Unknown, codes.Aborted, codes.DataLoss:
+		return outcomeFailure
+	case codes.Canceled:
+		return outcomeNeutral
+	default:
+		// бизнес-ошибки сервера (NotFound, InvalidArgument и т.п.) означают, что он отвечает
+		return outcomeSuccess
+	}
+}
@@ -0,0 +1,97 @@
+package grpcapp
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Sanchir01/currency-wallet/pkg/fakeexchanger"
+	walletsv1 "github.com/Sanchir01/wallets-proto/gen/go/wallets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func newTestClient(t *testing.T, srv *fakeexchanger.Server, opts ClientOptions) walletsv1.ExchangeServiceClient {
+	t.Helper()
+	dialOpts, stop := fakeexchanger.Bufconn(srv)
+	t.Cleanup(stop)
+	opts.DialOptions = dialOpts
+	client, err := NewClientGRPC(slog.New(slog.NewTextHandler(io.Discard, nil)), fakeexchanger.BufconnAddr, opts, walletsv1.NewExchangeServiceClient)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	return client
+}
+
+func testOptions() ClientOptions {
+	return ClientOptions{
+		Timeout:        time.Second,
+		AttemptTimeout: 200 * time.Millisecond,
+		Retries:        3,
+		BackoffBase:    time.Millisecond,
+		BackoffMax:     5 * time.Millisecond,
+		Breaker:        BreakerOptions{FailureThreshold: 2, OpenTimeout: time.Hour, HalfOpenMaxCalls: 1},
+	}
+}
+
+// TestClientRetriesTransientFailures — временные сбои скрыты повторами, вызывающий получает курс.
+func TestClientRetriesTransientFailures(t *testing.T) {
+	srv := fakeexchanger.New(map[string]float32{"USD": 1, "RUB": 90})
+	srv.FailNext(2, codes.Unavailable)
+	client := newTestClient(t, srv, testOptions())
+
+	resp, err := client.GetExchangeRateForCurrency(context.Background(), &walletsv1.CurrencyRequest{FromCurrency: "USD", ToCurrency: "RUB"})
+	if err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	if resp.Rate != 90 {
+		t.Errorf("rate = %v, want 90", resp.Rate)
+	}
+	if got := srv.Calls(); got != 3 {
+		t.Errorf("server calls = %d, want 3", got)
+	}
+}
+
+// TestClientBreakerOpensAfterFailures — после FailureThreshold неудачных вызовов сервер больше не вызывается.
+func TestClientBreakerOpensAfterFailures(t *testing.T) {
+	srv := fakeexchanger.New(map[string]float32{"USD": 1})
+	srv.SetFailRate(1, codes.Unavailable)
+	opts := testOptions()
+	opts.Retries = 0
+	client := newTestClient(t, srv, opts)
+
+	for range 2 {
+		if _, err := client.GetExchangeRates(context.Background(), &emptypb.Empty{}); status.Code(err) != codes.Unavailable {
+			t.Fatalf("err = %v, want Unavailable", err)
+		}
+	}
+	calls := srv.Calls()
+	_, err := client.GetExchangeRates(context.Background(), &emptypb.Empty{})
+	if err != ErrCircuitOpen {
+		t.Fatalf("err = %v, want ErrCircuitOpen", err)
+	}
+	if srv.Calls() != calls {
+		t.Errorf("server was called while the breaker is open")
+	}
+}
+
+// TestClientDeadline — медленный сервер не держит вызов дольше Timeout.
+func TestClientDeadline(t *testing.T) {
+	srv := fakeexchanger.New(map[string]float32{"USD": 1})
+	srv.SetLatency(time.Minute)
+	opts := testOptions()
+	opts.Timeout = 100 * time.Millisecond
+	client := newTestClient(t, srv, opts)
+
+	start := time.Now()
+	_, err := client.GetExchangeRates(context.Background(), &emptypb.Empty{})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("call took %v, want about %v", elapsed, opts.Timeout)
+	}
+}
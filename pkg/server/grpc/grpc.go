@@ -17,33 +17,54 @@ type App struct {
 	log *slog.Logger
 }
 
+// ClientOptions — ограничения исходящих вызовов. Timeout — общий срок вызова вместе с повторами,
+// AttemptTimeout — срок одной попытки, между попытками пауза растёт экспоненциально от BackoffBase
+// до BackoffMax со случайным разбросом, чтобы клиенты не повторяли запросы одновременно.
+type ClientOptions struct {
+	Timeout        time.Duration
+	AttemptTimeout time.Duration
+	Retries        int
+	BackoffBase    time.Duration
+	BackoffMax     time.Duration
+	Breaker        BreakerOptions
+	// DialOptions дополняют стандартные, например подключение к серверу в памяти в тестах
+	DialOptions []grpc.DialOption
+}
+
+// backoffJitter — доля случайного разброса паузы между попытками
+const backoffJitter = 0.2
+
 func NewClientGRPC[T any](
 	l *slog.Logger,
 	addr string,
-	timeout time.Duration,
-	retriesCount int,
+	opts ClientOptions,
 	clientFactory func(grpc.ClientConnInterface) T,
 ) (T, error) {
 	const op = "grpc.client.new"
 
 	retryOpts := []grpcretry.CallOption{
-		grpcretry.WithBackoff(grpcretry.BackoffLinear(500 * time.Millisecond)),
-		grpcretry.WithCodes(codes.Aborted, codes.Unavailable, codes.NotFound, codes.DeadlineExceeded),
-		grpcretry.WithPerRetryTimeout(timeout),
-		grpcretry.WithMax(uint(retriesCount)),
+		grpcretry.WithBackoff(grpcretry.BackoffExponentialWithJitterBounded(opts.BackoffBase, backoffJitter, opts.BackoffMax)),
+		grpcretry.WithCodes(codes.Aborted, codes.Unavailable, codes.DeadlineExceeded),
+		grpcretry.WithPerRetryTimeout(opts.AttemptTimeout),
+		grpcretry.WithMax(uint(opts.Retries)),
 	}
 
 	logOpts := []grpclog.Option{
 		grpclog.WithLogOnEvents(grpclog.PayloadReceived, grpclog.PayloadSent),
 	}
 
-	cc, err := grpc.NewClient(addr,
+	breaker := NewCircuitBreaker(addr, opts.Breaker, l)
+	dialOpts := append([]grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(
+			deadlineInterceptor(opts.Timeout),
+			metricsInterceptor(addr),
+			breaker.UnaryClientInterceptor(),
 			grpcretry.UnaryClientInterceptor(retryOpts...),
 			grpclog.UnaryClientInterceptor(logger.InterceptorsLogger(l), logOpts...),
 		),
-	)
+	}, opts.DialOptions...)
+	cc, err := grpc.NewClient(addr, dialOpts...)
 	if err != nil {
 		var zero T
 		return zero, err
@@ -0,0 +1,68 @@
+package grpcapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	prometheus.MustRegister(callDuration)
+	prometheus.MustRegister(breakerState)
+	prometheus.MustRegister(breakerRejected)
+}
+
+var callDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "grpc_client",
+		Name:      "call_duration_seconds",
+		Help:      "Duration of outgoing gRPC calls including retries.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+	},
+	[]string{"target", "method", "code"},
+)
+
+var breakerState = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "grpc_client",
+		Name:      "circuit_breaker_state",
+		Help:      "Circuit breaker state: 0 closed, 1 half-open, 2 open.",
+	},
+	[]string{"target"},
+)
+
+var breakerRejected = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "grpc_client",
+		Name:      "circuit_breaker_rejected_total",
+		Help:      "Calls rejected without reaching the server because the circuit breaker is open.",
+	},
+	[]string{"target"},
+)
+
+// metricsInterceptor измеряет длительность вызова вместе с повторами, отклонённые предохранителем
+// вызовы попадают в гистограмму с кодом Unavailable.
+func metricsInterceptor(target string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		callDuration.WithLabelValues(target, method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// deadlineInterceptor ограничивает вызов сроком timeout. Если у входящего контекста срок раньше
+// (например, дедлайн HTTP-запроса), остаётся он: gRPC передаёт его серверу в заголовке grpc-timeout.
+func deadlineInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -1,11 +1,146 @@
 package utils
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
+
+// Kind задаёт класс ошибки, по нему выбирается HTTP-статус.
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindInvalid
+	KindUnauthorized
+	KindForbidden
+	KindNotFound
+	KindConflict
+	KindUnprocessable
+	KindTooManyRequests
+	KindUnavailable
+	KindTooLarge
+)
+
+// Error — доменная ошибка со стабильным машиночитаемым кодом.
+// Code не меняется между версиями, клиенты ориентируются на него, а не на Message.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+}
+
+func NewError(kind Kind, code, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// AsError достаёт доменную ошибку из цепочки. Всё, что не является *Error, считается внутренней ошибкой.
+func AsError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return ErrorInternal
+}
 
 var (
-	ErrorQueryString       = errors.New("Error create query string")
-	ErrorUserAlreadyExists = errors.New("Username or email already exists")
-	ErrorUserNotFound      = errors.New("User not found")
-	ErrorInvalidPassword   = errors.New("Invalid password")
-	ErrorNotFoundRows      = errors.New("Error finding rows")
+	ErrorInternal           = NewError(KindInternal, "internal_error", "Internal server error")
+	ErrorQueryString        = NewError(KindInternal, "internal_error", "Error create query string")
+	ErrorNotFoundRows       = NewError(KindNotFound, "not_found", "Error finding rows")
+	ErrorInvalidRequestBody = NewError(KindInvalid, "invalid_request_body", "Request body is malformed")
+	ErrorValidation         = NewError(KindInvalid, "validation_failed", "Request validation failed")
+	ErrorRequestTooLarge    = NewError(KindTooLarge, "request_too_large", "Request body is too large")
+	ErrorUnauthorized       = NewError(KindUnauthorized, "unauthorized", "Unauthorized")
+	ErrorTooManyRequests    = NewError(KindTooManyRequests, "rate_limited", "Too many requests")
+
+	ErrorUserAlreadyExists  = NewError(KindConflict, "user_already_exists", "Username or email already exists")
+	ErrorUserNotFound       = NewError(KindNotFound, "user_not_found", "User not found")
+	ErrorInvalidPassword    = NewError(KindInvalid, "invalid_password", "Invalid password")
+	ErrorInvalidCredentials = NewError(KindUnauthorized, "invalid_credentials", "Invalid email or password")
+	ErrorInvalidToken       = NewError(KindInvalid, "invalid_token", "Token is invalid, expired or already used")
+	ErrorEmailNotVerified   = NewError(KindForbidden, "email_not_verified", "Email is not verified")
+
+	ErrorTwoFactorNotEnrolled     = NewError(KindInvalid, "two_factor_not_enrolled", "Two-factor authentication is not enrolled")
+	ErrorTwoFactorAlreadyEnabled  = NewError(KindConflict, "two_factor_already_enabled", "Two-factor authentication is already enabled")
+	ErrorTwoFactorRequired        = NewError(KindForbidden, "two_factor_required", "Two-factor code is required")
+	ErrorInvalidTwoFactorCode     = NewError(KindUnauthorized, "invalid_two_factor_code", "Invalid two-factor code")
+	ErrorInvalidTwoFactorAuthFlow = NewError(KindUnauthorized, "invalid_two_factor_challenge", "Invalid or expired two-factor challenge")
+
+	ErrorAccountLocked = NewError(KindTooManyRequests, "account_locked", "Account is temporarily locked")
+
+	ErrorVersionConflict      = NewError(KindConflict, "version_conflict", "Resource was modified by another request")
+	ErrorAccountHasBalance    = NewError(KindConflict, "account_has_balance", "Account still has funds on its wallets")
+	ErrorAccountHasOpenOrders = NewError(KindConflict, "account_has_open_orders", "Account still has open limit orders")
+	ErrorAccountHasPayments   = NewError(KindConflict, "account_has_pending_payments", "Account still has pending payments")
+	ErrorSessionNotFound      = NewError(KindNotFound, "session_not_found", "Session not found")
+	ErrorInvalidSessionID     = NewError(KindInvalid, "invalid_session_id", "Invalid session id")
+
+	ErrorInsufficientFunds       = NewError(KindUnprocessable, "insufficient_funds", "Insufficient funds")
+	ErrorUnknownCurrency         = NewError(KindInvalid, "unknown_currency", "Unknown currency")
+	ErrorSameCurrency            = NewError(KindInvalid, "same_currency", "Source and target currencies must differ")
+	ErrorWalletNotFound          = NewError(KindNotFound, "wallet_not_found", "Wallet not found")
+	ErrorExchangeRateUnavailable = NewError(KindUnavailable, "exchange_rate_unavailable", "Exchange rate is temporarily unavailable")
+	ErrorDuplicateOperation      = NewError(KindConflict, "duplicate_operation", "Operation has already been performed")
+	ErrorSelfTransfer            = NewError(KindInvalid, "self_transfer", "Cannot transfer to your own account")
+
+	ErrorScheduleNotFound    = NewError(KindNotFound, "schedule_not_found", "Schedule not found")
+	ErrorInvalidScheduleID   = NewError(KindInvalid, "invalid_schedule_id", "Invalid schedule id")
+	ErrorScheduleStartInPast = NewError(KindInvalid, "schedule_start_in_past", "Schedule start time must not be in the past")
+	ErrorScheduleClosed      = NewError(KindConflict, "schedule_closed", "Schedule is completed or cancelled and cannot be changed")
+
+	ErrorOrderNotFound      = NewError(KindNotFound, "order_not_found", "Limit order not found")
+	ErrorInvalidOrderID     = NewError(KindInvalid, "invalid_order_id", "Invalid order id")
+	ErrorOrderExpiryInvalid = NewError(KindInvalid, "order_expiry_invalid", "Order expiry must be in the future and within the allowed period")
+	ErrorOrderClosed        = NewError(KindConflict, "order_closed", "Limit order is already executed, cancelled or expired")
+
+	ErrorHoldNotFound       = NewError(KindNotFound, "hold_not_found", "Hold not found")
+	ErrorInvalidHoldID      = NewError(KindInvalid, "invalid_hold_id", "Invalid hold id")
+	ErrorHoldExpiryInvalid  = NewError(KindInvalid, "hold_expiry_invalid", "Hold expiry must be in the future and within the allowed period")
+	ErrorHoldClosed         = NewError(KindConflict, "hold_closed", "Hold is already captured, voided or expired")
+	ErrorCaptureExceedsHold = NewError(KindUnprocessable, "capture_exceeds_hold", "Capture amount exceeds the held amount")
+
+	ErrorPaymentNotFound            = NewError(KindNotFound, "payment_not_found", "Payment not found")
+	ErrorInvalidPaymentID           = NewError(KindInvalid, "invalid_payment_id", "Invalid payment id")
+	ErrorPaymentClosed              = NewError(KindConflict, "payment_closed", "Payment is already completed or failed")
+	ErrorPaymentMismatch            = NewError(KindUnprocessable, "payment_mismatch", "Provider callback does not match the payment")
+	ErrorInvalidWebhookSignature    = NewError(KindUnauthorized, "invalid_webhook_signature", "Webhook signature is invalid or expired")
+	ErrorUnknownPaymentProvider     = NewError(KindNotFound, "unknown_payment_provider", "Payment provider is not configured")
+	ErrorPaymentProviderUnavailable = NewError(KindUnavailable, "payment_provider_unavailable", "Payment provider is unavailable, try again later")
+	ErrorDirectDepositDisabled      = NewError(KindForbidden, "direct_deposit_disabled", "Direct deposits are disabled, top up through a payment provider")
+
+	ErrorStatementPeriodInvalid = NewError(KindInvalid, "statement_period_invalid", "Statement period end must not be before its start")
+
+	ErrorValuationPeriodInvalid = NewError(KindInvalid, "valuation_period_invalid", "Valuation period must not be reversed or longer than a year")
+
+	ErrorRateSnapshotNotFound = NewError(KindNotFound, "rate_snapshot_not_found", "No stored exchange rate for this pair at that time")
+	ErrorRateRangeInvalid     = NewError(KindInvalid, "rate_range_invalid", "Rate history range must not be reversed or longer than 1000 intervals")
+	ErrorRatePairHalted       = NewError(KindUnavailable, "rate_pair_halted", "Exchange for this pair is halted: rate sources disagree")
+
+	ErrorAdjustmentInvalid     = NewError(KindInvalid, "adjustment_invalid", "Adjustment needs a non-zero amount, a reason and an operator")
+	ErrorOutboxSelectorMissing = NewError(KindInvalid, "outbox_selector_required", "Select outbox events by id or by status")
+	ErrorRoleInvalid           = NewError(KindInvalid, "role_invalid", "Role change needs role user or admin and an operator")
+
+	ErrorAdminRequired      = NewError(KindForbidden, "admin_required", "Administrator role is required")
+	ErrorAuditFilterInvalid = NewError(KindInvalid, "audit_filter_invalid", "Audit log filters are malformed or the period is reversed")
 )
+
+// RetryAfterError сообщает, через сколько можно повторить операцию.
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}